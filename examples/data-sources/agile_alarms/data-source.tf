@@ -0,0 +1,10 @@
+data "agile_logical_network" "example" {
+  name = "example"
+}
+
+# Fails the run when the logical network raises critical or major alarms.
+data "agile_alarms" "example" {
+  logic_network_id = data.agile_logical_network.example.id
+  severities       = ["critical", "major"]
+  fail_on_alarms   = true
+}
@@ -0,0 +1,13 @@
+data "agile_controller" "this" {}
+
+# Only create a transit router when the controller release supports it.
+resource "agile_logical_router" "transit" {
+  count = contains(data.agile_controller.this.features, "logical_router_transit") ? 1 : 0
+
+  name     = "transit"
+  type     = "Transit"
+  vrf_name = "transit"
+  router_locations {
+    fabric_id = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
+  }
+}
@@ -0,0 +1,11 @@
+resource "agile_logical_switch" "example" {
+  name             = "example"
+  logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+}
+
+# Fails the run until the logical switch is configured on every device.
+data "agile_deployment_status" "example" {
+  object_type     = "logical_switch"
+  object_id       = agile_logical_switch.example.id
+  require_success = true
+}
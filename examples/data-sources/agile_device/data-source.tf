@@ -0,0 +1,7 @@
+data "agile_device" "example" {
+  name = "example"
+}
+
+data "agile_device" "by_ip" {
+  management_ip = "10.10.0.11"
+}
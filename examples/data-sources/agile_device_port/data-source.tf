@@ -0,0 +1,13 @@
+data "agile_device" "example" {
+  name = "example"
+}
+
+data "agile_device_port" "example" {
+  device_id      = data.agile_device.example.id
+  interface_name = "10GE1/0/12"
+}
+
+data "agile_device_port" "trunk" {
+  device_name    = "example"
+  interface_name = "Eth-Trunk1"
+}
@@ -0,0 +1,3 @@
+data "agile_dhcp_groups" "example" {
+  producer = "terraform"
+}
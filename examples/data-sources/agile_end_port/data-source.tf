@@ -0,0 +1,7 @@
+data "agile_end_port" "example" {
+  vm_name = "example"
+}
+
+output "example_ipv4" {
+  value = data.agile_end_port.example.ipv4
+}
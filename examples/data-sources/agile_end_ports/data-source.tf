@@ -0,0 +1,8 @@
+data "agile_logical_network" "example" {
+  name = "example"
+}
+
+data "agile_end_ports" "example" {
+  logic_network_id = data.agile_logical_network.example.id
+  name_regex       = "^web-"
+}
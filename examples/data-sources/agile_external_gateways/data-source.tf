@@ -0,0 +1,3 @@
+data "agile_external_gateways" "example" {
+  name_regex = "^internet"
+}
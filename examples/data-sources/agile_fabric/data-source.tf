@@ -1,3 +1,10 @@
 data "agile_fabric" "example" {
   name = "example"
-}
\ No newline at end of file
+}
+output "border_leaves" {
+  value = data.agile_fabric.example.border_leaf_device_ids
+}
+
+output "free_vnis" {
+  value = sum([for r in data.agile_fabric.example.vni_pool : r.free])
+}
@@ -0,0 +1,28 @@
+data "agile_fabric" "example" {
+  name = "example"
+}
+
+data "agile_fabric_free_values" "vni" {
+  fabric_id   = data.agile_fabric.example.id
+  type        = "vni"
+  range_start = 10000
+  range_end   = 19999
+  reserved    = [10000, 10001]
+}
+
+data "agile_fabric_free_values" "bd" {
+  fabric_id = data.agile_fabric.example.id
+  type      = "bd"
+}
+
+resource "agile_logical_switch" "example" {
+  name             = "example"
+  logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+  vni              = data.agile_fabric_free_values.vni.values[0]
+  bd               = data.agile_fabric_free_values.bd.values[0]
+
+  # Keep the values picked at creation once they are no longer free.
+  lifecycle {
+    ignore_changes = [vni, bd]
+  }
+}
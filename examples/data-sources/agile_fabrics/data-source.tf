@@ -0,0 +1 @@
+data "agile_fabrics" "all" {}
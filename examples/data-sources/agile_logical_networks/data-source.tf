@@ -0,0 +1,7 @@
+data "agile_tenant" "example" {
+  name = "example"
+}
+
+data "agile_logical_networks" "example" {
+  tenant_id = data.agile_tenant.example.id
+}
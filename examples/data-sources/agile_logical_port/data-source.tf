@@ -0,0 +1,12 @@
+data "agile_logical_port" "example" {
+  name = "example"
+}
+
+data "agile_logical_switch" "example" {
+  name = "example"
+}
+
+# Look up the logical port of a logical switch owned by another team.
+data "agile_logical_port" "scoped" {
+  logic_switch_id = data.agile_logical_switch.example.id
+}
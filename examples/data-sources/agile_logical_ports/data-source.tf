@@ -0,0 +1,7 @@
+data "agile_logical_switch" "example" {
+  name = "example"
+}
+
+data "agile_logical_ports" "example" {
+  logic_switch_id = data.agile_logical_switch.example.id
+}
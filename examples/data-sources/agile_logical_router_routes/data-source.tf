@@ -0,0 +1,12 @@
+data "agile_logical_router" "example" {
+  name = "example"
+}
+
+data "agile_logical_router_routes" "example" {
+  logic_router_id = data.agile_logical_router.example.id
+  protocol        = "Static"
+}
+
+output "static_destinations" {
+  value = distinct([for r in data.agile_logical_router_routes.example.routes : r.destination])
+}
@@ -0,0 +1,7 @@
+data "agile_logical_network" "example" {
+  name = "example"
+}
+
+data "agile_logical_routers" "example" {
+  logic_network_id = data.agile_logical_network.example.id
+}
@@ -1,3 +1,13 @@
 data "agile_logical_switch" "example" {
   name = "example"
-}
\ No newline at end of file
+}
+
+data "agile_logical_network" "example" {
+  name = "example"
+}
+
+# Names can be reused across logical networks, scope the lookup to select one.
+data "agile_logical_switch" "scoped" {
+  name             = "example"
+  logic_network_id = data.agile_logical_network.example.id
+}
@@ -0,0 +1,12 @@
+data "agile_logical_network" "example" {
+  name = "example"
+}
+
+data "agile_logical_switches" "example" {
+  logic_network_id = data.agile_logical_network.example.id
+  name_regex       = "^web_"
+}
+
+output "web_switch_vnis" {
+  value = { for s in data.agile_logical_switches.example.logical_switches : s.name => s.vni }
+}
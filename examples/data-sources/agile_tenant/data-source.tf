@@ -1,3 +1,7 @@
 data "agile_tenant" "example" {
   name = "example"
-}
\ No newline at end of file
+}
+# Remaining number of logical switches the tenant can create.
+output "logic_switch_headroom" {
+  value = data.agile_tenant.example.quota[0].logic_switch_num - data.agile_tenant.example.usage[0].logic_switch_num
+}
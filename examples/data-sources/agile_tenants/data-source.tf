@@ -0,0 +1,4 @@
+data "agile_tenants" "example" {
+  name_regex = "^prod_"
+  producer   = "terraform"
+}
@@ -0,0 +1,16 @@
+data "agile_vmm" "example" {
+  name = "example"
+}
+
+resource "agile_tenant" "example" {
+  name = "example"
+  quota {
+    logic_vas_num    = 10
+    logic_router_num = 10
+    logic_switch_num = 10
+  }
+  res_pool {
+    fabric_ids = data.agile_vmm.example.fabric_ids
+    vmm_ids    = [data.agile_vmm.example.id]
+  }
+}
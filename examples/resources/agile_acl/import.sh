@@ -0,0 +1,2 @@
+# import using the API/UI ID
+terraform import agile_acl.myacl 78750ef6-d054-4181-9143-4640dff220e1
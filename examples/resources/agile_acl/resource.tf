@@ -0,0 +1,24 @@
+resource "agile_acl" "example" {
+  name        = "example"
+  description = "This ACL is created by terraform"
+  tenant_id   = "cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"
+
+  rule {
+    action                 = "permit"
+    protocol               = "tcp"
+    source_ip              = "10.0.0.0/24"
+    destination_ip         = "10.0.1.0/24"
+    destination_port_begin = 443
+    destination_port_end   = 443
+  }
+
+  rule {
+    action = "deny"
+  }
+
+  bind_interface {
+    logic_router_id = "78750ef6-d054-4181-9143-4640dff220e1"
+    interface_name  = "Vbdif4067"
+    direction       = "inbound"
+  }
+}
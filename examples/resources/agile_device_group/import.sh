@@ -0,0 +1,2 @@
+# import using the API/UI ID
+terraform import agile_device_group.mygroup e13784fb-499f-4c30-8f9c-e49e6c98fdbb
@@ -0,0 +1,19 @@
+resource "agile_device_group" "example" {
+  name        = "example"
+  description = "Server leaf M-LAG pair"
+  fabric_id   = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
+  role        = "server_leaf"
+
+  device {
+    device_id = "9e3a5bee-3d95-3bf7-90f5-09bd2177324b"
+  }
+
+  device {
+    device_id = "b4f6d9ed-0f1d-3f7a-82f1-a4a7ea4f84d4"
+  }
+
+  mlag {
+    peer_link_port_ids = ["589c87dd-7222-3c09-87b7-d09a236af285"]
+    heartbeat_ip       = "192.168.100.1"
+  }
+}
@@ -0,0 +1,19 @@
+data "agile_dhcp_group" "example" {
+  name = "example"
+}
+
+resource "agile_dhcp_relay" "example" {
+  logic_switch_id = "6c0a96d3-0789-47e6-9dbc-66ac5ba2e519"
+  dhcp_group_id   = data.agile_dhcp_group.example.id
+
+  option82 {
+    enable   = true
+    strategy = "replace"
+  }
+}
+
+resource "agile_dhcp_relay" "router_interface" {
+  logic_router_id = "78750ef6-d054-4181-9143-4640dff220e1"
+  interface_name  = "Vbdif4067"
+  server_ips      = ["10.0.0.10", "10.0.0.11"]
+}
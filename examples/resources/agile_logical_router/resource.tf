@@ -9,4 +9,8 @@ resource "agile_logical_router" "example" {
     fabric_id   = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
     fabric_role = "master"
   }
+  router_locations {
+    fabric_id   = "804c7c74-5586-48bf-9cea-96a6d4d3f3a5"
+    fabric_role = "backup"
+  }
 }
\ No newline at end of file
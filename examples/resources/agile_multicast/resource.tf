@@ -0,0 +1,24 @@
+resource "agile_multicast" "example" {
+  logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+  logic_router_id  = "78750ef6-d054-4181-9143-4640dff220e1"
+
+  pim {
+    mode                = "sm"
+    hello_interval      = 30
+    join_prune_interval = 60
+  }
+
+  igmp {
+    version        = 3
+    query_interval = 60
+    robust_count   = 2
+  }
+
+  rendezvous_point {
+    address     = "10.10.10.1"
+    group_range = "239.0.0.0/8"
+  }
+
+  group_ranges = ["239.1.0.0/16"]
+  acl_id       = agile_acl.example.id
+}
@@ -0,0 +1,26 @@
+resource "agile_qos_policy" "example" {
+  name        = "example"
+  description = "This QoS Policy is created by terraform"
+  tenant_id   = "cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"
+  dscp_remark = 46
+
+  car {
+    cir      = 100
+    cir_unit = "mbps"
+    pir      = 200
+    cbs      = 10000
+    pbs      = 20000
+    cbs_unit = "byte"
+  }
+
+  priority_map {
+    dscp     = 46
+    priority = 5
+  }
+}
+
+resource "agile_logical_switch" "example" {
+  name             = "example"
+  logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+  qos_policy_id    = agile_qos_policy.example.id
+}
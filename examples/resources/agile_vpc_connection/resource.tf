@@ -0,0 +1,18 @@
+resource "agile_vpc_connection" "example" {
+  name              = "example"
+  description       = "Interconnection between production and shared services VPCs"
+  transit_router_id = "2f5f6d7e-65b2-4a77-9b0e-3e7b8f1b9c01"
+
+  peer {
+    logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+    logic_router_id  = "78750ef6-d054-4181-9143-4640dff220e1"
+    export_cidrs     = ["10.0.0.0/16"]
+  }
+
+  peer {
+    logic_network_id = "acfd8aaf-c6dc-499d-8020-bebd85b1f0e6"
+    logic_router_id  = "9d3b1c2e-4f5a-4b6c-8d7e-0f1a2b3c4d5e"
+    export_cidrs     = ["10.1.0.0/16"]
+    import_cidrs     = ["10.0.0.0/24"]
+  }
+}
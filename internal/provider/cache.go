@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"sync"
+	"time"
+)
+
+// cache holds controller list/get responses for the lifetime of a provider instance, so that
+// data sources and resource reads looking up the same objects share a single API call.
+// Concurrent lookups of the same key wait for the in-flight call instead of issuing their own.
+type cache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	ready   chan struct{}
+	value   interface{}
+	err     error
+	expires time.Time
+}
+
+// newCache returns a cache keeping responses for ttl. A zero ttl disables caching.
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// get returns the response cached under key, calling fetch when it is missing or expired.
+// Errors are returned to every caller waiting on the call but never cached.
+func (c *cache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if c == nil || c.ttl <= 0 {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && (entry.expires.IsZero() || time.Now().Before(entry.expires)) {
+		c.mu.Unlock()
+		<-entry.ready
+		return entry.value, entry.err
+	}
+
+	entry := &cacheEntry{ready: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	entry.value, entry.err = fetch()
+
+	c.mu.Lock()
+	entry.expires = time.Now().Add(c.ttl)
+	if entry.err != nil && c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(entry.ready)
+
+	return entry.value, entry.err
+}
+
+// invalidate drops every cached response. It is called around every write to the controller.
+func (c *cache) invalidate() {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	c.entries = make(map[string]*cacheEntry)
+	c.mu.Unlock()
+}
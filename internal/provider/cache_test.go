@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCacheDeduplicatesConcurrentGets(t *testing.T) {
+	c := newCache(time.Minute)
+
+	var fetches int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func() (interface{}, error) {
+		if atomic.AddInt32(&fetches, 1) == 1 {
+			close(started)
+		}
+		<-release
+		return "tenants", nil
+	}
+
+	var wg sync.WaitGroup
+	results := make(chan interface{}, 20)
+	get := func() {
+		defer wg.Done()
+		value, err := c.get("Tenants", fetch)
+		if err != nil {
+			t.Errorf("unexpected error %s", err)
+		}
+		results <- value
+	}
+
+	wg.Add(1)
+	go get()
+	<-started
+
+	for i := 0; i < 19; i++ {
+		wg.Add(1)
+		go get()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	if fetches != 1 {
+		t.Errorf("got %d fetches, want 1", fetches)
+	}
+
+	for value := range results {
+		if value != "tenants" {
+			t.Errorf("got %v, want tenants", value)
+		}
+	}
+}
+
+func TestCacheDoesNotCacheErrors(t *testing.T) {
+	c := newCache(time.Minute)
+
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		if fetches == 1 {
+			return nil, errors.New("controller unavailable")
+		}
+		return "tenants", nil
+	}
+
+	if _, err := c.get("Tenants", fetch); err == nil {
+		t.Fatal("expected the fetch error")
+	}
+
+	value, err := c.get("Tenants", fetch)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if value != "tenants" || fetches != 2 {
+		t.Errorf("got %v after %d fetches, want tenants after 2", value, fetches)
+	}
+}
+
+func TestCacheExpires(t *testing.T) {
+	c := newCache(20 * time.Millisecond)
+
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		return fetches, nil
+	}
+
+	c.get("Tenants", fetch)
+	if value, _ := c.get("Tenants", fetch); value != 1 {
+		t.Errorf("got %v before expiry, want 1", value)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if value, _ := c.get("Tenants", fetch); value != 2 {
+		t.Errorf("got %v after expiry, want 2", value)
+	}
+}
+
+func TestCacheInvalidateDuringFetch(t *testing.T) {
+	c := newCache(time.Minute)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		c.get("Tenants", func() (interface{}, error) {
+			close(started)
+			<-release
+			return "stale", nil
+		})
+		close(done)
+	}()
+
+	<-started
+	c.invalidate()
+	close(release)
+	<-done
+
+	value, err := c.get("Tenants", func() (interface{}, error) {
+		return "fresh", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if value != "fresh" {
+		t.Errorf("got %v, want fresh", value)
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	c := newCache(0)
+
+	fetches := 0
+	fetch := func() (interface{}, error) {
+		fetches++
+		return fetches, nil
+	}
+
+	c.get("Tenants", fetch)
+	c.get("Tenants", fetch)
+	if fetches != 2 {
+		t.Errorf("got %d fetches, want 2", fetches)
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Features that are only expected to be available starting with a given controller release.
+const (
+	featureStormSuppressUnits       = "storm_suppress_units"
+	featureLogicalRouterMultiActive = "logical_router_multi_active"
+	featureLogicalRouterTransit     = "logical_router_transit"
+	featureLogicalRouterLocations   = "logical_router_location_update"
+)
+
+// controllerFeatures maps each feature to the first controller release expected to support it.
+// The releases are not taken from a published compatibility matrix, so they only drive the
+// features attribute and warnings, never a failure: the controller has the final word.
+var controllerFeatures = map[string]string{
+	featureStormSuppressUnits:       "V300R020C00",
+	featureLogicalRouterMultiActive: "V300R019C10",
+	featureLogicalRouterTransit:     "V300R020C10",
+	featureLogicalRouterLocations:   "V300R020C00",
+}
+
+var controllerReleaseRegexp = regexp.MustCompile(`^V(\d+)R(\d+)C(\d+)`)
+
+// parseControllerRelease extracts the V, R and C numbers of a release such as V300R020C10SPC200.
+func parseControllerRelease(release string) ([]int, bool) {
+	match := controllerReleaseRegexp.FindStringSubmatch(release)
+	if match == nil {
+		return nil, false
+	}
+
+	numbers := make([]int, 0, 3)
+	for _, part := range match[1:] {
+		n, _ := strconv.Atoi(part)
+		numbers = append(numbers, n)
+	}
+	return numbers, true
+}
+
+// controllerSupports reports whether the controller release supports feature.
+// Unknown releases are assumed to support every feature, leaving validation to the controller.
+func controllerSupports(release string, feature string) bool {
+	current, ok := parseControllerRelease(release)
+	if !ok {
+		return true
+	}
+
+	minimum, _ := parseControllerRelease(controllerFeatures[feature])
+	for i := range current {
+		if current[i] != minimum[i] {
+			return current[i] > minimum[i]
+		}
+	}
+	return true
+}
+
+// controllerSupportedFeatures lists the features supported by the controller release, sorted by name.
+func controllerSupportedFeatures(release string) []string {
+	features := make([]string, 0)
+	for feature := range controllerFeatures {
+		if controllerSupports(release, feature) {
+			features = append(features, feature)
+		}
+	}
+	sort.Strings(features)
+	return features
+}
+
+// featureWarning warns when the controller probed at configure time predates the release expected
+// to support feature, naming the field of the configuration that needs it. The request is still
+// sent, the controller rejects it when it really lacks the feature.
+func (m *providerMeta) featureWarning(feature string, field string) diag.Diagnostics {
+	if m.controller == nil || m.controller.Version == nil {
+		return nil
+	}
+
+	if controllerSupports(*m.controller.Version, feature) {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s may not be supported by the Agile controller %s", field, controllerRelease(m.controller)),
+		Detail:   fmt.Sprintf("It is expected to require %s or later, the controller rejects the request if it does not support it.", controllerFeatures[feature]),
+	}}
+}
+
+// controllerVersion returns the release reported by the controller, or "unknown" when it omits it.
+func controllerVersion(controller *models.Controller) string {
+	if controller == nil || controller.Version == nil || *controller.Version == "" {
+		return "unknown"
+	}
+	return *controller.Version
+}
+
+func controllerRelease(controller *models.Controller) string {
+	if controller != nil && controller.Build != nil && *controller.Build != "" {
+		return fmt.Sprintf("%s (build %s)", controllerVersion(controller), *controller.Build)
+	}
+	return controllerVersion(controller)
+}
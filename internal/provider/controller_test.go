@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+)
+
+func TestControllerSupports(t *testing.T) {
+	cases := []struct {
+		release string
+		feature string
+		want    bool
+	}{
+		{"V300R020C10SPC200", featureLogicalRouterTransit, true},
+		{"V300R020C10", featureLogicalRouterTransit, true},
+		{"V300R020C00SPC100", featureLogicalRouterTransit, false},
+		{"V300R019C10", featureStormSuppressUnits, false},
+		{"V300R021C00", featureStormSuppressUnits, true},
+		{"V500R001C00", featureLogicalRouterMultiActive, true},
+		{"V300R019C10", featureLogicalRouterLocations, false},
+		{"unknown", featureLogicalRouterTransit, true},
+	}
+
+	for _, c := range cases {
+		if got := controllerSupports(c.release, c.feature); got != c.want {
+			t.Errorf("controllerSupports(%q, %q) = %t, want %t", c.release, c.feature, got, c.want)
+		}
+	}
+}
+
+func TestControllerSupportedFeatures(t *testing.T) {
+	got := controllerSupportedFeatures("V300R020C00")
+	want := []string{featureLogicalRouterLocations, featureLogicalRouterMultiActive, featureStormSuppressUnits}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("controllerSupportedFeatures = %v, want %v", got, want)
+	}
+}
+
+func TestControllerWithoutVersion(t *testing.T) {
+	controller := &models.Controller{Build: agile.String("20.1.0.100")}
+
+	if got := controllerRelease(controller); got != "unknown (build 20.1.0.100)" {
+		t.Errorf("controllerRelease = %q, want %q", got, "unknown (build 20.1.0.100)")
+	}
+
+	got := controllerSupportedFeatures(controllerVersion(controller))
+	if len(got) != len(controllerFeatures) {
+		t.Errorf("controllerSupportedFeatures = %v, want every feature", got)
+	}
+}
+
+func TestFeatureWarningNeverFails(t *testing.T) {
+	meta := &providerMeta{controller: &models.Controller{Version: agile.String("V300R019C10")}}
+
+	diags := meta.featureWarning(featureLogicalRouterTransit, "Logical router type Transit")
+	if len(diags) != 1 || diags.HasError() {
+		t.Errorf("featureWarning = %v, want a single warning", diags)
+	}
+
+	if diags := meta.featureWarning(featureLogicalRouterMultiActive, "Logical router type MultiActive"); diags != nil {
+		t.Errorf("featureWarning = %v, want no diagnostics", diags)
+	}
+}
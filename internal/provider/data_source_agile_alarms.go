@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strings"
+)
+
+func dataSourceAgileAlarms() *schema.Resource {
+	s := dataSourceListSchema("alarms", "Active alarms.", map[string]*schema.Schema{
+		"id": {
+			Description: "Alarm ID.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Alarm name.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"severity": {
+			Description: "Alarm severity, which can be critical, major, minor or warning.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"probable_cause": {
+			Description: "Probable cause of the alarm.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tenant_id": {
+			Description: "Tenant of the object raising the alarm.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"logic_network_id": {
+			Description: "Logical network of the object raising the alarm.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"device_id": {
+			Description: "Device raising the alarm.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"raised_at": {
+			Description: "Time the alarm was raised at.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}, "tenant_id", "logic_network_id", "device_id")
+
+	s["severities"] = &schema.Schema{
+		Description: "Severities the alarms must have, among critical, major, minor and warning.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(
+				validation.StringInSlice([]string{"critical", "major", "minor", "warning"}, true),
+			),
+		},
+	}
+	s["fail_on_alarms"] = &schema.Schema{
+		Description: "Whether to fail when at least one alarm matches the filters. Defaults to `false`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the active controller alarms matching the given filters. " +
+			"With `fail_on_alarms`, it fails when any alarm matches, which lets pipelines check a deployment did not raise alarms.",
+		ReadContext: dataSourceAgileAlarmsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceAgileAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Alarms: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	alarms, err := listAllAlarms(agileMeta, agile.AlarmRequestOpts{
+		TenantId:       d.Get("tenant_id").(string),
+		LogicNetworkId: d.Get("logic_network_id").(string),
+		DeviceId:       d.Get("device_id").(string),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	severities := make(map[string]bool)
+	for _, severity := range d.Get("severities").(*schema.Set).List() {
+		severities[strings.ToLower(severity.(string))] = true
+	}
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	descriptions := make([]string, 0)
+	for _, alarm := range alarms {
+		if !filter.match("tenant_id", alarm.TenantId) || !filter.match("logic_network_id", alarm.LogicNetworkId) || !filter.match("device_id", alarm.DeviceId) {
+			continue
+		}
+
+		if len(severities) > 0 && (alarm.Severity == nil || !severities[strings.ToLower(*alarm.Severity)]) {
+			continue
+		}
+
+		ids = append(ids, stringValue(alarm.Id))
+		items = append(items, map[string]interface{}{
+			"id":               alarm.Id,
+			"name":             alarm.Name,
+			"severity":         alarm.Severity,
+			"probable_cause":   alarm.ProbableCause,
+			"tenant_id":        alarm.TenantId,
+			"logic_network_id": alarm.LogicNetworkId,
+			"device_id":        alarm.DeviceId,
+			"raised_at":        alarm.RaisedAt,
+		})
+		descriptions = append(descriptions, stringValue(alarm.Severity)+" "+stringValue(alarm.Name)+": "+stringValue(alarm.ProbableCause))
+	}
+
+	if d.Get("fail_on_alarms").(bool) && len(ids) > 0 {
+		return diag.Errorf("%d active alarms found: %s", len(ids), strings.Join(descriptions, "; "))
+	}
+
+	return setDataSourceList(d, "alarms", ids, items)
+}
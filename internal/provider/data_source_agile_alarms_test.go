@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileAlarms(t *testing.T) {
+	dataSourceName := "data.agile_alarms.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileAlarms,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "alarms.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileAlarms = `
+data "agile_alarms" "this" {
+	logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+	severities       = ["critical", "major"]
+	fail_on_alarms   = true
+}
+`
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileController() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the release of the Agile controller the provider is connected to and the provider features it supports.",
+		ReadContext: dataSourceAgileControllerRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Controller release.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"version": {
+				Description: "Controller version, such as V300R020C10.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"build": {
+				Description: "Controller build number.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"nodes": {
+				Description: "Nodes of the controller cluster.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Node name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ip": {
+							Description: "Node IP address.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"role": {
+							Description: "Node role in the cluster.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Node status.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"features": {
+				Description: "Provider features expected to be supported by the controller release, informational only since the controller validates every request: `logical_router_location_update` (`router_locations` updates without recreating the router), `logical_router_multi_active`, `logical_router_transit` (Transit and Connect logical routers, VPC connections) and `storm_suppress_units`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgileControllerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Controller: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	controller := agileMeta.controller
+	if controller == nil {
+		var err error
+		if controller, err = agileMeta.client.GetController(); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(controllerRelease(controller))
+	if err := d.Set("version", controller.Version); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("build", controller.Build); err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodes := make([]interface{}, 0)
+	for _, node := range controller.Nodes {
+		nodes = append(nodes, map[string]interface{}{
+			"name":   node.Name,
+			"ip":     node.Ip,
+			"role":   node.Role,
+			"status": node.Status,
+		})
+	}
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("features", controllerSupportedFeatures(controllerVersion(controller))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileController(t *testing.T) {
+	dataSourceName := "data.agile_controller.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileController,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nodes.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "features.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileController = `
+data "agile_controller" "this" {}
+`
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strings"
+)
+
+// deploymentObjectTypes maps the object types of the data source to the controller ones.
+var deploymentObjectTypes = map[string]string{
+	"logical_network": "LogicNetwork",
+	"logical_router":  "LogicRouter",
+	"logical_switch":  "LogicSwitch",
+	"logical_port":    "LogicPort",
+}
+
+func dataSourceAgileDeploymentStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the deployment status of a logical object on the controller and on each device it is configured on. " +
+			"With `require_success`, it fails until every device is configured, which lets pipelines gate on a successful deployment.",
+		ReadContext: dataSourceAgileDeploymentStatusRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Data source ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"object_type": {
+				Description: "Type of the logical object, which can be logical_network, logical_router, logical_switch or logical_port.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice([]string{"logical_network", "logical_router", "logical_switch", "logical_port"}, false),
+				),
+			},
+			"object_id": {
+				Description:  "ID of the logical object.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"require_success": {
+				Description: "Whether to fail when the object is not successfully deployed on every device. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"status": {
+				Description: "Overall deployment status of the object, such as success, deploying or failed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"success": {
+				Description: "Whether the object is successfully deployed on every device.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"devices": {
+				Description: "Deployment status on each device.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Description: "Device ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"device_ip": {
+							Description: "Device management IP address.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Deployment status on the device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"failure_reason": {
+							Description: "Reason of the deployment failure on the device, if any.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgileDeploymentStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	objectType := d.Get("object_type").(string)
+	objectId := d.Get("object_id").(string)
+	log.Printf("[DEBUG] %s %s: Beginning Read of deployment status", objectType, objectId)
+
+	agileMeta := meta.(*providerMeta)
+
+	// Deployment status changes while devices are configured, it is never read from the cache.
+	deploymentStatus, err := agileMeta.client.GetDeploymentStatus(deploymentObjectTypes[objectType], objectId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	success := deploymentStatus.Status != nil && strings.EqualFold(*deploymentStatus.Status, "success")
+	failures := make([]string, 0)
+	devices := make([]interface{}, 0)
+	for _, device := range deploymentStatus.Devices {
+		if device.Status == nil || !strings.EqualFold(*device.Status, "success") {
+			success = false
+			reason := "not deployed"
+			if device.FailureReason != nil && *device.FailureReason != "" {
+				reason = *device.FailureReason
+			}
+			failures = append(failures, fmt.Sprintf("%s: %s", stringValue(device.DeviceIp, device.DeviceId), reason))
+		}
+
+		devices = append(devices, map[string]interface{}{
+			"device_id":      device.DeviceId,
+			"device_ip":      device.DeviceIp,
+			"status":         device.Status,
+			"failure_reason": device.FailureReason,
+		})
+	}
+
+	if d.Get("require_success").(bool) && !success {
+		message := fmt.Sprintf("%s %s is not deployed, status is %s", objectType, objectId, stringValue(deploymentStatus.Status))
+		if len(failures) > 0 {
+			message += ": " + strings.Join(failures, "; ")
+		}
+		return diag.Errorf("%s", message)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", objectType, objectId))
+	if err := d.Set("status", deploymentStatus.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("success", success); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("devices", devices); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+// stringValue returns the first non empty value, or "unknown".
+func stringValue(values ...*string) string {
+	for _, value := range values {
+		if value != nil && *value != "" {
+			return *value
+		}
+	}
+	return "unknown"
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileDeploymentStatus(t *testing.T) {
+	dataSourceName := "data.agile_deployment_status.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileDeploymentStatus,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "logical_network/5308df55-1709-404f-b4f8-4d8947d8f0c4"),
+					resource.TestCheckResourceAttr(dataSourceName, "success", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "devices.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileDeploymentStatus = `
+data "agile_deployment_status" "this" {
+	object_type     = "logical_network"
+	object_id       = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+	require_success = true
+}
+`
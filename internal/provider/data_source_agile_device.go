@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	underscore "github.com/ahl5esoft/golang-underscore"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+)
+
+func dataSourceAgileDevice() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve device by name, management IP or serial number.",
+		ReadContext: dataSourceAgileDeviceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "Device name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "management_ip", "serial_number"},
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 255),
+				),
+			},
+			"management_ip": {
+				Description:  "Device management IP.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"serial_number": {
+				Description: "Device serial number.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 255),
+				),
+			},
+			"id": {
+				Description: "Device ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"role": {
+				Description: "Device role in the fabric.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"fabric_id": {
+				Description: "Fabric to which the device belongs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"device_group_id": {
+				Description: "Device group to which the device belongs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Device status.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceAgileDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	managementIp := d.Get("management_ip").(string)
+	serialNumber := d.Get("serial_number").(string)
+	log.Printf("[DEBUG] %s%s%s: Beginning Read", name, managementIp, serialNumber)
+
+	agileMeta := meta.(*providerMeta)
+
+	devices, err := listAllDevices(agileMeta, agile.DeviceRequestOpts{
+		Name:         name,
+		ManagementIp: managementIp,
+		SerialNumber: serialNumber,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var device models.Device
+	underscore.Chain(devices).Find(func(dev models.Device, _ int) bool {
+		switch {
+		case name != "":
+			return dev.Name != nil && *dev.Name == name
+		case managementIp != "":
+			return dev.ManagementIp != nil && *dev.ManagementIp == managementIp
+		default:
+			return dev.SerialNumber != nil && *dev.SerialNumber == serialNumber
+		}
+	}).Value(&device)
+
+	if device.Id == nil || *device.Id == "" {
+		switch {
+		case name != "":
+			return diag.Errorf("No Device with name %s found", name)
+		case managementIp != "":
+			return diag.Errorf("No Device with management IP %s found", managementIp)
+		default:
+			return diag.Errorf("No Device with serial number %s found", serialNumber)
+		}
+	}
+
+	d.SetId(*device.Id)
+	d.Set("name", device.Name)
+	d.Set("management_ip", device.ManagementIp)
+	d.Set("serial_number", device.SerialNumber)
+	d.Set("role", device.Role)
+	d.Set("fabric_id", device.FabricId)
+	d.Set("device_group_id", device.DeviceGroupId)
+	d.Set("status", device.Status)
+	return nil
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	underscore "github.com/ahl5esoft/golang-underscore"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+)
+
+func dataSourceAgileDevicePort() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve device port by interface name.",
+		ReadContext: dataSourceAgileDevicePortRead,
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Description:  "Device to which the port belongs.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"device_id", "device_name"},
+				ValidateFunc: validation.IsUUID,
+			},
+			"device_name": {
+				Description: "Name of the device to which the port belongs.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 255),
+				),
+			},
+			"interface_name": {
+				Description: "Interface name, such as 10GE1/0/12 or Eth-Trunk1.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringDoesNotContainAny(" "),
+					),
+				),
+			},
+			"id": {
+				Description: "Port ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"port_id": {
+				Description: "Port ID, to be used in the `location` blocks of `agile_logical_port`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"port_name": {
+				Description: "Port name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"speed": {
+				Description: "Port speed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Port link status.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"in_use": {
+				Description: "Whether the port is already used by a logical port.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"logic_port_ids": {
+				Description: "Logical ports using the port.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgileDevicePortRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	interfaceName := d.Get("interface_name").(string)
+	log.Printf("[DEBUG] %s: Beginning Read", interfaceName)
+
+	agileMeta := meta.(*providerMeta)
+
+	deviceId := d.Get("device_id").(string)
+	deviceName := d.Get("device_name").(string)
+
+	var device models.Device
+	if deviceId == "" {
+		devices, err := listAllDevices(agileMeta, agile.DeviceRequestOpts{Name: deviceName})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		underscore.Chain(devices).Find(func(dev models.Device, _ int) bool {
+			return dev.Name != nil && *dev.Name == deviceName
+		}).Value(&device)
+
+		if device.Id == nil || *device.Id == "" {
+			return diag.Errorf("No Device with name %s found", deviceName)
+		}
+
+		deviceId = *device.Id
+	} else {
+		found, err := getDevice(agileMeta, deviceId)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		device = *found
+		deviceName = stringValue(device.Name, device.Id)
+	}
+
+	ports, err := listAllDevicePorts(agileMeta, deviceId, agile.DevicePortRequestOpts{Name: interfaceName})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var port models.DevicePort
+	underscore.Chain(ports).Find(func(p models.DevicePort, _ int) bool {
+		return p.Name != nil && *p.Name == interfaceName
+	}).Value(&port)
+
+	if port.Id == nil || *port.Id == "" {
+		return diag.Errorf("No Port with interface name %s found on device %s", interfaceName, deviceName)
+	}
+
+	// Logical ports bound to the port belong to the fabric of its device.
+	var fabricId string
+	if device.FabricId != nil {
+		fabricId = *device.FabricId
+	}
+	logicalPorts, err := listAllLogicalPorts(agileMeta, agile.LogicalPortRequestOpts{FabricId: fabricId})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	logicPortIds := make([]string, 0)
+	for _, logicalPort := range logicalPorts {
+		if logicalPort.AccessInfo == nil {
+			continue
+		}
+		for _, location := range logicalPort.AccessInfo.Location {
+			if location != nil && location.PortId != nil && *location.PortId == *port.Id && logicalPort.Id != nil {
+				logicPortIds = append(logicPortIds, *logicalPort.Id)
+				break
+			}
+		}
+	}
+
+	d.SetId(*port.Id)
+	d.Set("device_id", deviceId)
+	d.Set("device_name", deviceName)
+	d.Set("port_id", port.Id)
+	d.Set("port_name", port.Name)
+	d.Set("speed", port.Speed)
+	d.Set("status", port.Status)
+	d.Set("in_use", len(logicPortIds) > 0)
+	d.Set("logic_port_ids", logicPortIds)
+	return nil
+}
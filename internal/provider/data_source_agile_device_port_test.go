@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileDevicePort(t *testing.T) {
+	dataSourceName := "data.agile_device_port.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileDevicePort,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "589c87dd-7222-3c09-87b7-d09a236af285"),
+					resource.TestCheckResourceAttr(dataSourceName, "port_id", "589c87dd-7222-3c09-87b7-d09a236af285"),
+					resource.TestCheckResourceAttr(dataSourceName, "port_name", "10GE1/0/12"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "device_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "speed"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "in_use"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "data.agile_device_port.by_device_name", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileDevicePort = `
+data "agile_device_port" "this" {
+ device_id      = "9e3a5bee-3d95-3bf7-90f5-09bd2177324b"
+ interface_name = "10GE1/0/12"
+}
+
+data "agile_device_port" "by_device_name" {
+ device_name    = data.agile_device_port.this.device_name
+ interface_name = "10GE1/0/12"
+}
+`
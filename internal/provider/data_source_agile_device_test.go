@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileDevice(t *testing.T) {
+	dataSourceName := "data.agile_device.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileDevice,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "9e3a5bee-3d95-3bf7-90f5-09bd2177324b"),
+					resource.TestCheckResourceAttr(dataSourceName, "fabric_id", "f1429224-1860-4bdb-8cc8-98ccc0f5563a"),
+					resource.TestCheckResourceAttr(dataSourceName, "device_group_id", "e13784fb-499f-4c30-8f9c-e49e6c98fdbb"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "management_ip"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "serial_number"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "role"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "data.agile_device.by_ip", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "data.agile_device.by_serial_number", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileDevice = `
+data "agile_device" "this" {
+ name = "leaf1"
+}
+
+data "agile_device" "by_ip" {
+ management_ip = data.agile_device.this.management_ip
+}
+
+data "agile_device" "by_serial_number" {
+ serial_number = data.agile_device.this.serial_number
+}
+`
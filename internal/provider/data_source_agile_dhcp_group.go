@@ -13,13 +13,15 @@ import (
 
 func dataSourceAgileDhcpGroup() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source can be used to retrieve DHCP Group by name.",
+		Description: "Data source can be used to retrieve DHCP Group by name or ID.",
 		ReadContext: dataSourceAgileDhcpGroupRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "DHCP server name.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "DHCP server name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.All(
 						validation.StringLenBetween(1, 255),
@@ -28,9 +30,11 @@ func dataSourceAgileDhcpGroup() *schema.Resource {
 				),
 			},
 			"id": {
-				Description: "DHCP server ID.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "DHCP server ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"description": {
 				Description: "DHCP server description.",
@@ -57,26 +61,28 @@ func dataSourceAgileDhcpGroup() *schema.Resource {
 }
 
 func dataSourceAgileDhcpGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get("name").(string)
-	log.Printf("[DEBUG] %s: Beginning Read", name)
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
-	dhcpGroups, err := agileClient.ListDHCPGroups(nil)
+	dhcpGroups, err := listAllDHCPGroups(agileMeta, agile.DHCPGroupRequestOpts{Name: d.Get("name").(string)})
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var dhcpGroup models.DHCPGroup
-	underscore.Chain(dhcpGroups).Find(func(d models.DHCPGroup, _ int) bool {
-		return *d.Name == name
-	}).Value(&dhcpGroup)
+	var matches []models.DHCPGroup
+	underscore.Chain(dhcpGroups).Filter(func(d models.DHCPGroup, _ int) bool {
+		return filter.match("id", d.Id) && filter.match("name", d.Name)
+	}).Value(&matches)
 
-	if dhcpGroup.Id == nil || *dhcpGroup.Id == "" {
-		return diag.Errorf("No Dhcp Group with name %s found", name)
+	if err := filter.lookupError("Dhcp Group", len(matches)); err != nil {
+		return err
 	}
 
+	dhcpGroup := matches[0]
+
 	d.SetId(*dhcpGroup.Id)
 	d.Set("name", *dhcpGroup.Name)
 	d.Set("description", *dhcpGroup.Description)
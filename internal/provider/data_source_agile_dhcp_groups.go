@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileDhcpGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of DHCP Groups matching the given filters.",
+		ReadContext: dataSourceAgileDhcpGroupsRead,
+		Schema: dataSourceListSchema("dhcp_groups", "Matching DHCP groups.", map[string]*schema.Schema{
+			"id": {
+				Description: "DHCP server ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "DHCP server name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "DHCP server description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"producer": {
+				Description: "Producer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_router_id": {
+				Description: "ID of the logical router that the DHCP server belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"vrf_name": {
+				Description: "VRF Name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}, "name_regex", "producer"),
+	}
+}
+
+func dataSourceAgileDhcpGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] DHCP Groups: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	dhcpGroups, err := listAllDHCPGroups(agileMeta, agile.DHCPGroupRequestOpts{})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, dhcpGroup := range dhcpGroups {
+		if !filter.matchName(dhcpGroup.Name) || !filter.match("producer", dhcpGroup.Producer) {
+			continue
+		}
+
+		ids = append(ids, *dhcpGroup.Id)
+		items = append(items, map[string]interface{}{
+			"id":              dhcpGroup.Id,
+			"name":            dhcpGroup.Name,
+			"description":     dhcpGroup.Description,
+			"producer":        dhcpGroup.Producer,
+			"logic_router_id": dhcpGroup.LogicRouterId,
+			"vrf_name":        dhcpGroup.VrfName,
+		})
+	}
+
+	return setDataSourceList(d, "dhcp_groups", ids, items)
+}
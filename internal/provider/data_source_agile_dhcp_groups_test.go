@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileDhcpGroups(t *testing.T) {
+	dataSourceName := "data.agile_dhcp_groups.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileDhcpGroups,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "dhcp_groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "dhcp_groups.0.name", "TESTE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "dhcp_groups.0.id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileDhcpGroups = `
+data "agile_dhcp_groups" "this" {
+ name_regex = "^TESTE$"
+}
+`
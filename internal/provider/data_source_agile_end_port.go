@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	underscore "github.com/ahl5esoft/golang-underscore"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"terraform-provider-agile/tools"
+)
+
+func dataSourceAgileEndPort() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve End Port by name, ID or terminal name. The lookup can be scoped with `logic_network_id`.",
+		ReadContext: dataSourceAgileEndPortRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "End port name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "vm_name"},
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 255),
+				),
+			},
+			"id": {
+				Description:  "End port ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"vm_name": {
+				Description: "Terminal name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 255),
+				),
+			},
+			"description": {
+				Description: "End port description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_network_id": {
+				Description:  "Logical network to which the end port belongs. When set, only objects belonging to it are looked up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"logic_port_id": {
+				Description: "ID of the associated logical port.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"location": {
+				Description: "Location.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ipv4": {
+				Description: "End port IPv4 addresses.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ipv6": {
+				Description: "End port IPv6 addresses.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgileEndPortRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
+
+	agileMeta := meta.(*providerMeta)
+
+	// An id lookup reads the object directly instead of walking every page, the other
+	// arguments are still checked against it below.
+	var endPorts []models.EndPort
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		var found *models.EndPort
+		if found, err = getEndPort(agileMeta, id.(string)); err == nil {
+			endPorts = []models.EndPort{*found}
+		}
+	} else {
+		endPorts, err = listAllEndPorts(agileMeta, agile.EndPortRequestOpts{
+			Name:           d.Get("name").(string),
+			VmName:         d.Get("vm_name").(string),
+			LogicNetworkId: d.Get("logic_network_id").(string),
+		})
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var matches []models.EndPort
+	underscore.Chain(endPorts).Filter(func(e models.EndPort, _ int) bool {
+		return filter.match("id", e.Id) && filter.match("name", e.Name) && filter.match("vm_name", e.VmName) && filter.match("logic_network_id", e.LogicNetworkId)
+	}).Value(&matches)
+
+	if err := filter.lookupError("End port", len(matches), "logic_network_id"); err != nil {
+		return err
+	}
+
+	endPort := matches[0]
+
+	d.SetId(*endPort.Id)
+	if err := d.Set("name", endPort.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("vm_name", endPort.VmName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("description", endPort.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("logic_network_id", endPort.LogicNetworkId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("logic_port_id", endPort.LogicPortId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("location", endPort.Location); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ipv4", tools.CreateSliceOfStrings(endPort.Ipv4)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("ipv6", tools.CreateSliceOfStrings(endPort.Ipv6)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
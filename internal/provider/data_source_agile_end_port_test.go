@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceAgileEndPort(t *testing.T) {
+	dataSourceName := "data.agile_end_port.this"
+	resourceName := "agile_end_port.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileEndPort,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logic_port_id", resourceName, "logic_port_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logic_network_id", resourceName, "logic_network_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "location", resourceName, "location"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ipv4.0", resourceName, "ipv4"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ipv6.0", resourceName, "ipv6"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileEndPort = `
+resource "agile_end_port" "this" {
+	name             = "tf_acc_tests_end_port"
+	description      = "End Port created via Terraform Tests"
+	logic_port_id    = "8682b032-6bb3-46f8-b7b5-2c8bcfceefff"
+	logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+	location         = "10"
+	vm_name          = "tf_acc_tests_vm"
+	ipv4             = "192.168.1.1"
+	ipv6             = "FE80::A1"
+}
+
+data "agile_end_port" "this" {
+	vm_name = agile_end_port.this.vm_name
+}
+`
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileEndPorts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of End Ports matching the given filters.",
+		ReadContext: dataSourceAgileEndPortsRead,
+		Schema: dataSourceListSchema("end_ports", "Matching end ports.", map[string]*schema.Schema{
+			"id": {
+				Description: "End port ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "End port name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "End port description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"vm_name": {
+				Description: "Terminal name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_network_id": {
+				Description: "Logical network to which the end port belongs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_port_id": {
+				Description: "ID of the associated logical port.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"location": {
+				Description: "Location.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}, "name_regex", "tenant_id", "logic_network_id"),
+	}
+}
+
+func dataSourceAgileEndPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] End Ports: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	endPorts, err := listAllEndPorts(agileMeta, agile.EndPortRequestOpts{
+		LogicNetworkId: d.Get("logic_network_id").(string),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// End ports only reference their logical network, the tenant filter goes through the tenant networks.
+	var tenantNetworks map[string]bool
+	if tenantId, ok := d.GetOk("tenant_id"); ok {
+		logicalNetworks, err := listAllLogicalNetworks(agileMeta, agile.LogicalNetworkRequestOpts{
+			TenantId: tenantId.(string),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		tenantNetworks = make(map[string]bool)
+		for _, logicalNetwork := range logicalNetworks {
+			if logicalNetwork.Id != nil && logicalNetwork.TenantId != nil && *logicalNetwork.TenantId == tenantId.(string) {
+				tenantNetworks[*logicalNetwork.Id] = true
+			}
+		}
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, endPort := range endPorts {
+		if !filter.matchName(endPort.Name) || !filter.match("logic_network_id", endPort.LogicNetworkId) {
+			continue
+		}
+
+		if tenantNetworks != nil && (endPort.LogicNetworkId == nil || !tenantNetworks[*endPort.LogicNetworkId]) {
+			continue
+		}
+
+		ids = append(ids, *endPort.Id)
+		items = append(items, map[string]interface{}{
+			"id":               endPort.Id,
+			"name":             endPort.Name,
+			"description":      endPort.Description,
+			"vm_name":          endPort.VmName,
+			"logic_network_id": endPort.LogicNetworkId,
+			"logic_port_id":    endPort.LogicPortId,
+			"location":         endPort.Location,
+		})
+	}
+
+	return setDataSourceList(d, "end_ports", ids, items)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileEndPorts(t *testing.T) {
+	dataSourceName := "data.agile_end_ports.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileEndPorts,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "end_ports.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "agile_end_port.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "end_ports.0.id", "agile_end_port.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "end_ports.0.name", "agile_end_port.this", "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "end_ports.0.vm_name", "agile_end_port.this", "vm_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileEndPorts = `
+resource "agile_end_port" "this" {
+	name             = "tf_acc_tests_end_ports"
+	description      = "End Port created via Terraform Tests"
+	logic_port_id    = "8682b032-6bb3-46f8-b7b5-2c8bcfceefff"
+	logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+	location         = "10"
+	vm_name          = "tf_acc_tests_vm"
+	ipv4             = "192.168.1.1"
+	ipv6             = "FE80::A1"
+}
+
+data "agile_end_ports" "this" {
+ name_regex       = "^${agile_end_port.this.name}$"
+ tenant_id        = "cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"
+ logic_network_id = agile_end_port.this.logic_network_id
+}
+`
@@ -13,13 +13,15 @@ import (
 
 func dataSourceAgileExternalGateway() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source can be used to retrieve External Gateways by name.",
+		Description: "Data source can be used to retrieve External Gateways by name or ID.",
 		ReadContext: dataSourceAgileExternalGatewayRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "External gateway name.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "External gateway name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.All(
 						validation.StringLenBetween(1, 255),
@@ -28,9 +30,11 @@ func dataSourceAgileExternalGateway() *schema.Resource {
 				),
 			},
 			"id": {
-				Description: "External gateway ID.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "External gateway ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"description": {
 				Description: "External gateway description.",
@@ -57,26 +61,28 @@ func dataSourceAgileExternalGateway() *schema.Resource {
 }
 
 func dataSourceAgileExternalGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get("name").(string)
-	log.Printf("[DEBUG] %s: Beginning Read", name)
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
-	externalGateways, err := agileClient.ListExternalGateways(nil)
+	externalGateways, err := listAllExternalGateways(agileMeta, agile.ExternalGatewayRequestOpts{Name: d.Get("name").(string)})
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var externalGateway models.ExternalGateway
-	underscore.Chain(externalGateways).Find(func(e models.ExternalGateway, _ int) bool {
-		return *e.Name == name
-	}).Value(&externalGateway)
+	var matches []models.ExternalGateway
+	underscore.Chain(externalGateways).Filter(func(e models.ExternalGateway, _ int) bool {
+		return filter.match("id", e.Id) && filter.match("name", e.Name)
+	}).Value(&matches)
 
-	if externalGateway.Id == nil || *externalGateway.Id == "" {
-		return diag.Errorf("No External Gateway with name %s found", name)
+	if err := filter.lookupError("External Gateway", len(matches)); err != nil {
+		return err
 	}
 
+	externalGateway := matches[0]
+
 	d.SetId(*externalGateway.Id)
 	d.Set("name", *externalGateway.Name)
 	d.Set("description", *externalGateway.Description)
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileExternalGateways() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of External Gateways matching the given filters.",
+		ReadContext: dataSourceAgileExternalGatewaysRead,
+		Schema: dataSourceListSchema("external_gateways", "Matching external gateways.", map[string]*schema.Schema{
+			"id": {
+				Description: "External gateway ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "External gateway name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "External gateway description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gateway_type": {
+				Description: "External gateway type, which can be Public or Private.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_telco_gateway": {
+				Description: "Indicates if is a Telco cloud gateway.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"vrf_name": {
+				Description: "VRF Name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}, "name_regex"),
+	}
+}
+
+func dataSourceAgileExternalGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] External Gateways: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	externalGateways, err := listAllExternalGateways(agileMeta, agile.ExternalGatewayRequestOpts{})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, externalGateway := range externalGateways {
+		if !filter.matchName(externalGateway.Name) {
+			continue
+		}
+
+		ids = append(ids, *externalGateway.Id)
+		items = append(items, map[string]interface{}{
+			"id":               externalGateway.Id,
+			"name":             externalGateway.Name,
+			"description":      externalGateway.Description,
+			"gateway_type":     externalGateway.GatewayType,
+			"is_telco_gateway": externalGateway.IsTelcoGateway,
+			"vrf_name":         externalGateway.VrfName,
+		})
+	}
+
+	return setDataSourceList(d, "external_gateways", ids, items)
+}
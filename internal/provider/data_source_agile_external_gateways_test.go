@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileExternalGateways(t *testing.T) {
+	dataSourceName := "data.agile_external_gateways.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileExternalGateways,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "external_gateways.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "data.agile_external_gateway.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "external_gateways.0.id", "data.agile_external_gateway.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "external_gateways.0.name", "data.agile_external_gateway.this", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileExternalGateways = `
+data "agile_external_gateway" "this" {
+ name = "TESTE"
+}
+
+data "agile_external_gateways" "this" {
+ name_regex = "^${data.agile_external_gateway.this.name}$"
+}
+`
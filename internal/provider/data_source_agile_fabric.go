@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	underscore "github.com/ahl5esoft/golang-underscore"
 	agile "github.com/claranet/agilec-go-client/client"
 	"github.com/claranet/agilec-go-client/models"
@@ -13,13 +14,15 @@ import (
 
 func dataSourceAgileFabric() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source can be used to retrieve fabric by name.",
+		Description: "Data source can be used to retrieve fabric by name or ID.",
 		ReadContext: dataSourceAgileFabricRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Fabric name.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Fabric name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.All(
 						validation.StringLenBetween(1, 128),
@@ -28,9 +31,11 @@ func dataSourceAgileFabric() *schema.Resource {
 				),
 			},
 			"id": {
-				Description: "Fabric ID.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "Fabric ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"description": {
 				Description: "Fabric description.",
@@ -57,31 +62,122 @@ func dataSourceAgileFabric() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"devices": {
+				Description: "Member devices of the fabric.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Device ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Device name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"management_ip": {
+							Description: "Device management IP address.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"role": {
+							Description: "Device role in the fabric.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"device_group_id": {
+							Description: "Device group to which the device belongs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Device status.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"spine_device_ids": {
+				Description: "Devices playing the spine role.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"border_leaf_device_ids": {
+				Description: "Devices playing the border leaf role.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"server_leaf_device_ids": {
+				Description: "Devices playing the server leaf role.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_leaf_device_ids": {
+				Description: "Devices playing the service leaf role.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"vni_pool": {
+				Description: "VNI ranges of the fabric resource pool, used by logical switches and routers. Left empty, with a warning, when the pools cannot be collected.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        fabricPoolRangeSchema(),
+			},
+			"bd_pool": {
+				Description: "BD ranges of the fabric resource pool, used by logical switches. Left empty, with a warning, when the pools cannot be collected.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        fabricPoolRangeSchema(),
+			},
+			"vlan_pool": {
+				Description: "VLAN ranges of the fabric resource pool, used by logical ports. Left empty, with a warning, when the pools cannot be collected.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        fabricPoolRangeSchema(),
+			},
 		},
 	}
 }
 
 func dataSourceAgileFabricRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get("name").(string)
-	log.Printf("[DEBUG] %s: Beginning Read", name)
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
-	fabrics, err := agileClient.ListFabrics(nil)
+	fabrics, err := listAllFabrics(agileMeta, agile.FabricRequestOpts{Name: d.Get("name").(string)})
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var fabric models.Fabric
-	underscore.Chain(fabrics).Find(func(f models.Fabric, _ int) bool {
-		return *f.Name == name
-	}).Value(&fabric)
+	var matches []models.Fabric
+	underscore.Chain(fabrics).Filter(func(f models.Fabric, _ int) bool {
+		return filter.match("id", f.Id) && filter.match("name", f.Name)
+	}).Value(&matches)
 
-	if fabric.Id == nil || *fabric.Id == "" {
-		return diag.Errorf("No Fabric with name %s found", name)
+	if err := filter.lookupError("Fabric", len(matches)); err != nil {
+		return err
 	}
 
+	fabric := matches[0]
+
 	d.SetId(*fabric.Id)
 	d.Set("name", *fabric.Name)
 	d.Set("description", *fabric.Description)
@@ -89,5 +185,221 @@ func dataSourceAgileFabricRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("physical_network_mode", *fabric.PhysicalNetworkMode)
 	d.Set("multicast_capability", *fabric.MulticastCapability)
 	d.Set("micro_segment", *fabric.MicroSegmentCapability)
-	return nil
+
+	devices, err := listAllDevices(agileMeta, agile.DeviceRequestOpts{FabricId: *fabric.Id})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := make([]interface{}, 0)
+	roles := map[string][]string{
+		"spine":        {},
+		"border_leaf":  {},
+		"server_leaf":  {},
+		"service_leaf": {},
+	}
+	for _, device := range devices {
+		if device.FabricId == nil || *device.FabricId != *fabric.Id {
+			continue
+		}
+
+		members = append(members, map[string]interface{}{
+			"id":              device.Id,
+			"name":            device.Name,
+			"management_ip":   device.ManagementIp,
+			"role":            device.Role,
+			"device_group_id": device.DeviceGroupId,
+			"status":          device.Status,
+		})
+
+		if device.Role != nil {
+			if ids, ok := roles[*device.Role]; ok {
+				roles[*device.Role] = append(ids, *device.Id)
+			}
+		}
+	}
+
+	if err := d.Set("devices", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for role, ids := range roles {
+		if err := d.Set(role+"_device_ids", ids); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Pools and their usage need extra calls, failing to collect them must not break the fabric lookup.
+	var diags diag.Diagnostics
+	vniPool, bdPool, vlanPool := make([]interface{}, 0), make([]interface{}, 0), make([]interface{}, 0)
+	pool, err := getFabricResourcePool(agileMeta, *fabric.Id)
+	if err == nil {
+		var usage *fabricPoolUsed
+		if usage, err = fabricPoolUsage(agileMeta, *fabric.Id); err == nil {
+			vniPool = flattenFabricPoolRanges(pool.Vni, usage.vni)
+			bdPool = flattenFabricPoolRanges(pool.Bd, usage.bd)
+			vlanPool = flattenFabricPoolRanges(pool.Vlan, usage.vlan)
+		}
+	}
+
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Fabric resource pools could not be collected",
+			Detail:   fmt.Sprintf("vni_pool, bd_pool and vlan_pool of fabric %s are left empty: %s", *fabric.Id, err),
+		})
+	}
+
+	if err := d.Set("vni_pool", vniPool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("bd_pool", bdPool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("vlan_pool", vlanPool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return diags
+}
+
+func fabricPoolRangeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"start": {
+				Description: "First value of the range.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"end": {
+				Description: "Last value of the range.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"size": {
+				Description: "Number of values in the range.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used": {
+				Description: "Number of values of the range in use.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"free": {
+				Description: "Number of values of the range still available.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// fabricPoolUsed holds the VNI, BD and VLAN values in use in a fabric.
+type fabricPoolUsed struct {
+	vni  map[int32]bool
+	bd   map[int32]bool
+	vlan map[int32]bool
+}
+
+// fabricPoolUsage collects the VNIs and BDs of the logical switches and routers of the logical
+// networks attached to the fabric, and the VLANs of the logical ports of the fabric.
+func fabricPoolUsage(agileMeta *providerMeta, fabricId string) (*fabricPoolUsed, error) {
+	used := &fabricPoolUsed{
+		vni:  make(map[int32]bool),
+		bd:   make(map[int32]bool),
+		vlan: make(map[int32]bool),
+	}
+
+	logicalNetworks, err := listAllLogicalNetworks(agileMeta, agile.LogicalNetworkRequestOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	networks := make(map[string]bool)
+	for _, logicalNetwork := range logicalNetworks {
+		for _, id := range logicalNetwork.FabricId {
+			if id != nil && *id == fabricId {
+				networks[*logicalNetwork.Id] = true
+			}
+		}
+	}
+
+	logicalSwitches, err := listAllLogicalSwitches(agileMeta, agile.LogicalSwitchRequestOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, logicalSwitch := range logicalSwitches {
+		if logicalSwitch.LogicNetworkId == nil || !networks[*logicalSwitch.LogicNetworkId] {
+			continue
+		}
+		if logicalSwitch.Vni != nil {
+			used.vni[*logicalSwitch.Vni] = true
+		}
+		if logicalSwitch.Bd != nil {
+			used.bd[*logicalSwitch.Bd] = true
+		}
+	}
+
+	logicalRouters, err := listAllLogicalRouters(agileMeta, agile.LogicalRouterRequestOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, logicalRouter := range logicalRouters {
+		if logicalRouter.LogicNetworkId == nil || !networks[*logicalRouter.LogicNetworkId] {
+			continue
+		}
+		if logicalRouter.Vni != nil {
+			used.vni[*logicalRouter.Vni] = true
+		}
+	}
+
+	logicalPorts, err := listAllLogicalPorts(agileMeta, agile.LogicalPortRequestOpts{FabricId: fabricId})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, logicalPort := range logicalPorts {
+		if logicalPort.FabricId == nil || *logicalPort.FabricId != fabricId {
+			continue
+		}
+		if logicalPort.AccessInfo != nil && logicalPort.AccessInfo.Vlan != nil {
+			used.vlan[*logicalPort.AccessInfo.Vlan] = true
+		}
+	}
+
+	return used, nil
+}
+
+func flattenFabricPoolRanges(ranges []*models.FabricResourceRange, used map[int32]bool) []interface{} {
+	items := make([]interface{}, 0)
+	for _, r := range ranges {
+		if r == nil || r.Start == nil || r.End == nil {
+			continue
+		}
+
+		size := int(*r.End-*r.Start) + 1
+		inUse := 0
+		for value := range used {
+			if value >= *r.Start && value <= *r.End {
+				inUse++
+			}
+		}
+
+		items = append(items, map[string]interface{}{
+			"start": int(*r.Start),
+			"end":   int(*r.End),
+			"size":  size,
+			"used":  inUse,
+			"free":  size - inUse,
+		})
+	}
+	return items
 }
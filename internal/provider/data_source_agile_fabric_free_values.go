@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fabricValueLimits holds the bounds accepted by the controller for each kind of fabric value.
+var fabricValueLimits = map[string][2]int{
+	"vni":  {1, 16000000},
+	"bd":   {1, 16000000},
+	"vlan": {2, 4094},
+}
+
+func dataSourceAgileFabricFreeValues() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to find the next free VNIs, BDs or VLANs of a fabric, for instance to set `vni` and `bd` of `agile_logical_switch` without collisions. " +
+			"Values used by the logical switches, logical routers and logical ports of the fabric are skipped. " +
+			"Values are only read, not reserved: two configurations reading the data source concurrently get the same values.",
+		ReadContext: dataSourceAgileFabricFreeValuesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Data source ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"fabric_id": {
+				Description:  "Fabric to look up free values in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"type": {
+				Description: "Kind of value to look up, which can be vni, bd or vlan.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice([]string{"vni", "bd", "vlan"}, false),
+				),
+			},
+			"count": {
+				Description: "Number of free values to return. Defaults to `1`.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.IntBetween(1, 1000),
+				),
+			},
+			"range_start": {
+				Description:  "First value of the range to look up free values in. If `range_start` and `range_end` are not set, the ranges of the fabric resource pool are used.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"range_end"},
+				ValidateFunc: validation.IntBetween(1, 16000000),
+			},
+			"range_end": {
+				Description:  "Last value of the range to look up free values in.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"range_start"},
+				ValidateFunc: validation.IntBetween(1, 16000000),
+			},
+			"reserved": {
+				Description: "Values never returned, such as values allocated outside of the controller.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(1, 16000000),
+				},
+			},
+			"values": {
+				Description: "Free values, in ascending order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgileFabricFreeValuesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	fabricId := d.Get("fabric_id").(string)
+	valueType := d.Get("type").(string)
+	count := d.Get("count").(int)
+	log.Printf("[DEBUG] %s: Beginning Read of free %s values", fabricId, valueType)
+
+	agileMeta := meta.(*providerMeta)
+
+	var ranges []*models.FabricResourceRange
+	if start, ok := d.GetOk("range_start"); ok {
+		end := d.Get("range_end").(int)
+		limits := fabricValueLimits[valueType]
+		if start.(int) > end || start.(int) < limits[0] || end > limits[1] {
+			return diag.Errorf("range_start and range_end must define a range between %d and %d for type %s.", limits[0], limits[1], valueType)
+		}
+		ranges = []*models.FabricResourceRange{{
+			Start: agile.Int32(int32(start.(int))),
+			End:   agile.Int32(int32(end)),
+		}}
+	} else {
+		pool, err := getFabricResourcePool(agileMeta, fabricId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		switch valueType {
+		case "vni":
+			ranges = pool.Vni
+		case "bd":
+			ranges = pool.Bd
+		case "vlan":
+			ranges = pool.Vlan
+		}
+	}
+
+	usage, err := fabricPoolUsage(agileMeta, fabricId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	used := map[string]map[int32]bool{
+		"vni":  usage.vni,
+		"bd":   usage.bd,
+		"vlan": usage.vlan,
+	}[valueType]
+
+	for _, value := range d.Get("reserved").(*schema.Set).List() {
+		used[int32(value.(int))] = true
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i] != nil && ranges[i].Start != nil && (ranges[j] == nil || ranges[j].Start == nil || *ranges[i].Start < *ranges[j].Start)
+	})
+
+	values := make([]int, 0, count)
+	for _, r := range ranges {
+		if r == nil || r.Start == nil || r.End == nil {
+			continue
+		}
+		for value := *r.Start; value <= *r.End && len(values) < count; value++ {
+			if !used[value] {
+				used[value] = true
+				values = append(values, int(value))
+			}
+		}
+	}
+
+	if len(values) < count {
+		return diag.Errorf("Only %d free %s values found in fabric %s, %d requested.", len(values), valueType, fabricId, count)
+	}
+
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, strconv.Itoa(value))
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", fabricId, valueType, strings.Join(ids, ",")))
+
+	if err := d.Set("values", values); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileFabricFreeValues(t *testing.T) {
+	dataSourceName := "data.agile_fabric_free_values.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileFabricFreeValues,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "values.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "values.0", "15001"),
+					resource.TestCheckResourceAttr(dataSourceName, "values.1", "15003"),
+					resource.TestCheckResourceAttr(dataSourceName, "values.2", "15004"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAgileFabricFreeValues_Exhausted(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAgileFabricFreeValuesExhausted,
+				ExpectError: regexp.MustCompile("Only 1 free vlan values found"),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileFabricFreeValues = `
+data "agile_fabric_free_values" "this" {
+	fabric_id   = "804c7c74-5586-48bf-9cea-96a6d4d3f3a5"
+	type        = "vni"
+	count       = 3
+	range_start = 15001
+	range_end   = 15100
+	reserved    = [15002]
+}
+`
+
+const testAccDataSourceAgileFabricFreeValuesExhausted = `
+data "agile_fabric_free_values" "this" {
+	fabric_id   = "804c7c74-5586-48bf-9cea-96a6d4d3f3a5"
+	type        = "vlan"
+	count       = 2
+	range_start = 4000
+	range_end   = 4001
+	reserved    = [4000]
+}
+`
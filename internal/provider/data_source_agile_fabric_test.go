@@ -23,6 +23,12 @@ func TestAccDataSourceAgileFabric(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "physical_network_mode", "Vxlan"),
 					resource.TestCheckResourceAttr(dataSourceName, "multicast_capability", "false"),
 					resource.TestCheckResourceAttr(dataSourceName, "micro_segment", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "devices.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "spine_device_ids.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vni_pool.0.start"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vni_pool.0.free"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "bd_pool.0.start"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vlan_pool.0.start"),
 				),
 			},
 		},
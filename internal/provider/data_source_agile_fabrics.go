@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileFabrics() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of Fabrics matching the given filters.",
+		ReadContext: dataSourceAgileFabricsRead,
+		Schema: dataSourceListSchema("fabrics", "Matching fabrics.", map[string]*schema.Schema{
+			"id": {
+				Description: "Fabric ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Fabric name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Fabric description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"network_type": {
+				Description: "Fabric VXLAN type. The value is Distributed or Centralized.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"physical_network_mode": {
+				Description: "Fabric networking type. Only VXLAN is supported.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"micro_segment": {
+				Description: "Whether to enable microsegmentation.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"multicast_capability": {
+				Description: "Whether the multicast capability is supported.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		}, "name_regex"),
+	}
+}
+
+func dataSourceAgileFabricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Fabrics: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	fabrics, err := listAllFabrics(agileMeta, agile.FabricRequestOpts{})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, fabric := range fabrics {
+		if !filter.matchName(fabric.Name) {
+			continue
+		}
+
+		ids = append(ids, *fabric.Id)
+		items = append(items, map[string]interface{}{
+			"id":                    fabric.Id,
+			"name":                  fabric.Name,
+			"description":           fabric.Description,
+			"network_type":          fabric.NetworkType,
+			"physical_network_mode": fabric.PhysicalNetworkMode,
+			"micro_segment":         fabric.MicroSegmentCapability,
+			"multicast_capability":  fabric.MulticastCapability,
+		})
+	}
+
+	return setDataSourceList(d, "fabrics", ids, items)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileFabrics(t *testing.T) {
+	dataSourceName := "data.agile_fabrics.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileFabrics,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "fabrics.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "data.agile_fabric.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fabrics.0.id", "data.agile_fabric.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fabrics.0.name", "data.agile_fabric.this", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileFabrics = `
+data "agile_fabric" "this" {
+ name = "tesye"
+}
+
+data "agile_fabrics" "this" {
+ name_regex = "^${data.agile_fabric.this.name}$"
+}
+`
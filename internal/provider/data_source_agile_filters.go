@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataSourceFilterSchemas holds the filter arguments that plural data sources can accept.
+var dataSourceFilterSchemas = map[string]*schema.Schema{
+	"name_regex": {
+		Description:  "Regular expression the object names must match.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	},
+	"tenant_id": {
+		Description:  "Tenant the objects must belong to.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	},
+	"logic_network_id": {
+		Description:  "Logical network the objects must belong to.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	},
+	"logic_switch_id": {
+		Description:  "Logical switch the objects must belong to.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	},
+	"device_id": {
+		Description:  "Device the objects must be located on.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	},
+	"producer": {
+		Description: "Producer the objects must have been created by.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+}
+
+// dataSourceListSchema builds the schema of a plural data source: the given filters,
+// the list of matching ids and the list of matching objects described by elem.
+func dataSourceListSchema(listKey string, listDescription string, elem map[string]*schema.Schema, filters ...string) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"id": {
+			Description: "Data source ID.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ids": {
+			Description: "IDs of the matching objects.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		listKey: {
+			Description: listDescription,
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: elem,
+			},
+		},
+	}
+
+	for _, filter := range filters {
+		s[filter] = dataSourceFilterSchemas[filter]
+	}
+
+	return s
+}
+
+type dataSourceFilter struct {
+	d         *schema.ResourceData
+	nameRegex *regexp.Regexp
+}
+
+func newDataSourceFilter(d *schema.ResourceData) *dataSourceFilter {
+	filter := &dataSourceFilter{d: d}
+
+	if val, ok := d.GetOk("name_regex"); ok {
+		filter.nameRegex = regexp.MustCompile(val.(string))
+	}
+
+	return filter
+}
+
+// matchName reports whether name matches the name_regex filter, if any.
+func (f *dataSourceFilter) matchName(name *string) bool {
+	if f.nameRegex == nil {
+		return true
+	}
+	return name != nil && f.nameRegex.MatchString(*name)
+}
+
+// match reports whether value equals the filter argument key, if it is set.
+func (f *dataSourceFilter) match(key string, value *string) bool {
+	val, ok := f.d.GetOk(key)
+	if !ok {
+		return true
+	}
+	return value != nil && *value == val.(string)
+}
+
+func setDataSourceList(d *schema.ResourceData, listKey string, ids []string, items []interface{}) diag.Diagnostics {
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(ids, ","))))
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(listKey, items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// lookupCriteria describes the lookup arguments set on a singular data source, such as "name web, tenant_id ...".
+func (f *dataSourceFilter) lookupCriteria() string {
+	criteria := make([]string, 0)
+	for _, key := range []string{"id", "name", "vm_name", "tenant_id", "logic_network_id", "logic_switch_id"} {
+		if val, ok := f.d.GetOk(key); ok {
+			criteria = append(criteria, fmt.Sprintf("%s %s", key, val.(string)))
+		}
+	}
+	return strings.Join(criteria, ", ")
+}
+
+// lookupError returns the error raised by a singular data source when its lookup arguments
+// do not match exactly one object. scopes lists the arguments that can disambiguate a name.
+func (f *dataSourceFilter) lookupError(kind string, matches int, scopes ...string) diag.Diagnostics {
+	switch {
+	case matches == 0:
+		return diag.Errorf("No %s with %s found", kind, f.lookupCriteria())
+	case matches > 1:
+		hint := "id"
+		if len(scopes) > 0 {
+			hint = fmt.Sprintf("id, %s", strings.Join(scopes, ", "))
+		}
+		return diag.Errorf("%d objects of type %s with %s found, set %s to select one", matches, kind, f.lookupCriteria(), hint)
+	}
+	return nil
+}
@@ -14,13 +14,15 @@ import (
 
 func dataSourceAgileLogicalNetwork() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source can be used to retrieve Logical Network by name.",
+		Description: "Data source can be used to retrieve Logical Network by name or ID. The name lookup can be scoped with `tenant_id`.",
 		ReadContext: dataSourceAgileLogicalNetworkRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Logical network name.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Logical network name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.All(
 						validation.StringLenBetween(1, 255),
@@ -29,9 +31,11 @@ func dataSourceAgileLogicalNetwork() *schema.Resource {
 				),
 			},
 			"id": {
-				Description: "Logical network ID.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "Logical network ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"description": {
 				Description: "Logical network description.",
@@ -39,9 +43,11 @@ func dataSourceAgileLogicalNetwork() *schema.Resource {
 				Computed:    true,
 			},
 			"tenant_id": {
-				Description: "Tenant to which a logical network (VPC) belongs.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "Tenant to which a logical network (VPC) belongs. When set, only objects belonging to it are looked up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"fabrics_id": {
 				Description: "ID of the fabrics associated with the logical network.",
@@ -86,26 +92,42 @@ func dataSourceAgileLogicalNetwork() *schema.Resource {
 }
 
 func dataSourceAgileLogicalNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get("name").(string)
-	log.Printf("[DEBUG] %s: Beginning Read", name)
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
-	logicalNetworks, err := agileClient.ListLogicalNetworks(nil)
+	// An id lookup reads the object directly instead of walking every page, the other
+	// arguments are still checked against it below.
+	var logicalNetworks []models.LogicalNetwork
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		var found *models.LogicalNetwork
+		if found, err = getLogicalNetwork(agileMeta, id.(string)); err == nil {
+			logicalNetworks = []models.LogicalNetwork{*found}
+		}
+	} else {
+		logicalNetworks, err = listAllLogicalNetworks(agileMeta, agile.LogicalNetworkRequestOpts{
+			Name:     d.Get("name").(string),
+			TenantId: d.Get("tenant_id").(string),
+		})
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var logicalNetwork models.LogicalNetwork
-	underscore.Chain(logicalNetworks).Find(func(l models.LogicalNetwork, _ int) bool {
-		return *l.Name == name
-	}).Value(&logicalNetwork)
+	var matches []models.LogicalNetwork
+	underscore.Chain(logicalNetworks).Filter(func(l models.LogicalNetwork, _ int) bool {
+		return filter.match("id", l.Id) && filter.match("name", l.Name) && filter.match("tenant_id", l.TenantId)
+	}).Value(&matches)
 
-	if logicalNetwork.Id == nil || *logicalNetwork.Id == "" {
-		return diag.Errorf("No Logical Network with name %s found", name)
+	if err := filter.lookupError("Logical Network", len(matches), "tenant_id"); err != nil {
+		return err
 	}
 
+	logicalNetwork := matches[0]
+
 	d.SetId(*logicalNetwork.Id)
 	d.Set("name", logicalNetwork.Name)
 	d.Set("description", *logicalNetwork.Description)
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"terraform-provider-agile/tools"
+)
+
+func dataSourceAgileLogicalNetworks() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of Logical Networks matching the given filters.",
+		ReadContext: dataSourceAgileLogicalNetworksRead,
+		Schema: dataSourceListSchema("logical_networks", "Matching logical networks.", map[string]*schema.Schema{
+			"id": {
+				Description: "Logical network ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Logical network name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Logical network description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tenant_id": {
+				Description: "Tenant to which a logical network (VPC) belongs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"fabrics_id": {
+				Description: "ID of the fabrics associated with the logical network.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"type": {
+				Description: "Logical network type, which can be Instance or Transit.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"multicast_capability": {
+				Description: "Whether the multicast capability is supported.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"producer": {
+				Description: "Producer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_vpc_deployed": {
+				Description: "Indicates if VPC is deployed",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		}, "name_regex", "tenant_id", "producer"),
+	}
+}
+
+func dataSourceAgileLogicalNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Logical Networks: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	logicalNetworks, err := listAllLogicalNetworks(agileMeta, agile.LogicalNetworkRequestOpts{
+		TenantId: d.Get("tenant_id").(string),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, logicalNetwork := range logicalNetworks {
+		var producer *string
+		if logicalNetwork.Additional != nil {
+			producer = logicalNetwork.Additional.Producer
+		}
+
+		if !filter.matchName(logicalNetwork.Name) || !filter.match("tenant_id", logicalNetwork.TenantId) || !filter.match("producer", producer) {
+			continue
+		}
+
+		ids = append(ids, *logicalNetwork.Id)
+		items = append(items, map[string]interface{}{
+			"id":                   logicalNetwork.Id,
+			"name":                 logicalNetwork.Name,
+			"description":          logicalNetwork.Description,
+			"tenant_id":            logicalNetwork.TenantId,
+			"fabrics_id":           tools.CreateSliceOfStrings(logicalNetwork.FabricId),
+			"type":                 logicalNetwork.Type,
+			"multicast_capability": logicalNetwork.MulticastCapability,
+			"producer":             producer,
+			"is_vpc_deployed":      logicalNetwork.IsVpcDeployed,
+		})
+	}
+
+	return setDataSourceList(d, "logical_networks", ids, items)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileLogicalNetworks(t *testing.T) {
+	dataSourceName := "data.agile_logical_networks.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileLogicalNetworks,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "logical_networks.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "agile_logical_network.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logical_networks.0.id", "agile_logical_network.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logical_networks.0.name", "agile_logical_network.this", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileLogicalNetworks = `
+resource "agile_logical_network" "this" {
+	name        = "tf_acc_tests_logicalNetworks"
+	description = "Logical Network created via Terraform Tests"
+	type = "Transit"
+	multicast_capability = "false"
+	tenant_id = "7e0ba3e8-280d-420c-951a-b2fe79b4b68a"
+	fabrics_id = [ "f1429224-1860-4bdb-8cc8-98ccc0f5563a" ]
+}
+
+data "agile_logical_networks" "this" {
+ name_regex = "^${agile_logical_network.this.name}$"
+ tenant_id  = agile_logical_network.this.tenant_id
+}
+`
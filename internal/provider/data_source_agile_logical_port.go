@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	underscore "github.com/ahl5esoft/golang-underscore"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+)
+
+func dataSourceAgileLogicalPort() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve Logical Port by name, ID or logical switch. The name lookup can be scoped with `tenant_id` and `logic_switch_id`.",
+		ReadContext: dataSourceAgileLogicalPortRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "Logical port name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "logic_switch_id"},
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 255),
+				),
+			},
+			"id": {
+				Description:  "Logical port ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"description": {
+				Description: "Logical port description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tenant_id": {
+				Description:  "Tenant to which a logical port belongs. When set, only objects belonging to it are looked up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"fabric_id": {
+				Description: "Fabric to which the logical port belongs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_switch_id": {
+				Description:  "Logical switch to which a logical port belongs. When set, only objects belonging to it are looked up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"qos_policy_id": {
+				Description: "QoS policy applied to the logical port.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"access_info": {
+				Description: "Access info Settings.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Description: "Port mode, which can be UNI or NNI.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Logical port type, which can be DOT1Q, DEFAULT, UNTAG, or QINQ.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"vlan": {
+							Description: "Access VLAN ID.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"qinq": {
+							Description: "Qinq Settings.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"inner_vid_begin": {
+										Description: "Start VLAN ID of the inner VLAN tag for QinQ.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"inner_vid_end": {
+										Description: "End VLAN ID of the inner VLAN tag for QinQ.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"outer_vid_begin": {
+										Description: "Start VLAN ID of the outer VLAN tag for QinQ.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"outer_vid_end": {
+										Description: "End VLAN ID of the outer VLAN tag for QinQ.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"rewrite_action": {
+										Description: "Rewrite action of QinQ, which can be POPDOUBLE or PASSTHROUGH.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"location": {
+							Description: "Physical ports the logical port is bound to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_group_id": {
+										Description: "Device group ID of a physical device.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"device_id": {
+										Description: "Physical device.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"port_id": {
+										Description: "Physical port.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"port_name": {
+										Description: "Port name.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"device_ip": {
+										Description: "Device management IP address.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"subinterface_number": {
+							Description: "Number of an access sub-interface.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"additional": {
+				Description: "Additional Settings.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"producer": {
+							Description: "Producer of the logical port.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgileLogicalPortRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
+
+	agileMeta := meta.(*providerMeta)
+
+	// An id lookup reads the object directly instead of walking every page, the other
+	// arguments are still checked against it below.
+	var logicalPorts []models.LogicalPort
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		var found *models.LogicalPort
+		if found, err = getLogicalPort(agileMeta, id.(string)); err == nil {
+			logicalPorts = []models.LogicalPort{*found}
+		}
+	} else {
+		logicalPorts, err = listAllLogicalPorts(agileMeta, agile.LogicalPortRequestOpts{
+			Name:          d.Get("name").(string),
+			TenantId:      d.Get("tenant_id").(string),
+			LogicSwitchId: d.Get("logic_switch_id").(string),
+		})
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var matches []models.LogicalPort
+	underscore.Chain(logicalPorts).Filter(func(l models.LogicalPort, _ int) bool {
+		return filter.match("id", l.Id) && filter.match("name", l.Name) && filter.match("tenant_id", l.TenantId) && filter.match("logic_switch_id", l.LogicSwitchId)
+	}).Value(&matches)
+
+	if err := filter.lookupError("Logical port", len(matches), "tenant_id", "logic_switch_id"); err != nil {
+		return err
+	}
+
+	logicalPort := matches[0]
+
+	d.SetId(*logicalPort.Id)
+	if err := d.Set("name", logicalPort.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("description", logicalPort.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("tenant_id", logicalPort.TenantId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("fabric_id", logicalPort.FabricId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("logic_switch_id", logicalPort.LogicSwitchId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("qos_policy_id", logicalPort.QosPolicyId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("access_info", flattenLogicalPortAccessInfo(logicalPort.AccessInfo)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if logicalPort.Additional != nil {
+		if err := d.Set("additional", []interface{}{
+			map[string]*string{
+				"producer": logicalPort.Additional.Producer,
+			},
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+// flattenLogicalPortAccessInfo converts the access info of a logical port, which may be partially
+// filled by the controller, into the computed access_info block of the data source.
+func flattenLogicalPortAccessInfo(accessInfo *models.LogicalPortAccessInfo) []interface{} {
+	if accessInfo == nil {
+		return []interface{}{}
+	}
+
+	qinq := make([]interface{}, 0)
+	if accessInfo.Qinq != nil {
+		qinq = append(qinq, map[string]interface{}{
+			"inner_vid_begin": accessInfo.Qinq.InnerVidBegin,
+			"inner_vid_end":   accessInfo.Qinq.InnerVidEnd,
+			"outer_vid_begin": accessInfo.Qinq.OuterVidBegin,
+			"outer_vid_end":   accessInfo.Qinq.OuterVidEnd,
+			"rewrite_action":  accessInfo.Qinq.RewriteAction,
+		})
+	}
+
+	locations := make([]interface{}, 0)
+	for _, location := range accessInfo.Location {
+		locations = append(locations, map[string]interface{}{
+			"device_group_id": location.DeviceGroupId,
+			"device_id":       location.DeviceId,
+			"port_id":         location.PortId,
+			"port_name":       location.PortName,
+			"device_ip":       location.DeviceIp,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                accessInfo.Mode,
+			"type":                accessInfo.Type,
+			"vlan":                accessInfo.Vlan,
+			"qinq":                qinq,
+			"location":            locations,
+			"subinterface_number": accessInfo.SubinterfaceNumber,
+		},
+	}
+}
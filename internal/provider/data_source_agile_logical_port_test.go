@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceAgileLogicalPort(t *testing.T) {
+	dataSourceName := "data.agile_logical_port.this"
+	resourceName := "agile_logical_port.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileLogicalPort,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tenant_id", resourceName, "tenant_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fabric_id", resourceName, "fabric_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logic_switch_id", resourceName, "logic_switch_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_info.0.mode", "Uni"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_info.0.type", "Dot1q"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_info.0.vlan", "1218"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_info.0.location.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_info.0.location.0.port_id", "589c87dd-7222-3c09-87b7-d09a236af285"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_info.0.location.0.port_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileLogicalPort = `
+resource "agile_logical_port" "this" {
+	name            = "tf_acc_tests_logical_port"
+	description     = "Logical Port created via Terraform Tests"
+	tenant_id       = "11ade37a-79d0-482f-a7a0-6ad070e1d05d"
+	fabric_id       = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
+	logic_switch_id = "6c0a96d3-0789-47e6-9dbc-66ac5ba2e519"
+	access_info {
+		mode = "Uni"
+		type = "Dot1q"
+		vlan = 1218
+		location {
+			device_group_id = "e13784fb-499f-4c30-8f9c-e49e6c98fdbb"
+			device_id       = "9e3a5bee-3d95-3bf7-90f5-09bd2177324b"
+			port_id         = "589c87dd-7222-3c09-87b7-d09a236af285"
+		}
+	}
+}
+
+data "agile_logical_port" "this" {
+	name            = agile_logical_port.this.name
+	logic_switch_id = agile_logical_port.this.logic_switch_id
+}
+`
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileLogicalPorts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of Logical Ports matching the given filters.",
+		ReadContext: dataSourceAgileLogicalPortsRead,
+		Schema: dataSourceListSchema("logical_ports", "Matching logical ports.", map[string]*schema.Schema{
+			"id": {
+				Description: "Logical port ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Logical port name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Logical port description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tenant_id": {
+				Description: "Tenant ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"fabric_id": {
+				Description: "Fabric ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_switch_id": {
+				Description: "Logical switch to which the logical port belongs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"producer": {
+				Description: "Producer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}, "name_regex", "tenant_id", "logic_switch_id", "producer"),
+	}
+}
+
+func dataSourceAgileLogicalPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Logical Ports: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	logicalPorts, err := listAllLogicalPorts(agileMeta, agile.LogicalPortRequestOpts{
+		TenantId:      d.Get("tenant_id").(string),
+		LogicSwitchId: d.Get("logic_switch_id").(string),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, logicalPort := range logicalPorts {
+		var producer *string
+		if logicalPort.Additional != nil {
+			producer = logicalPort.Additional.Producer
+		}
+
+		if !filter.matchName(logicalPort.Name) || !filter.match("tenant_id", logicalPort.TenantId) ||
+			!filter.match("logic_switch_id", logicalPort.LogicSwitchId) || !filter.match("producer", producer) {
+			continue
+		}
+
+		ids = append(ids, *logicalPort.Id)
+		items = append(items, map[string]interface{}{
+			"id":              logicalPort.Id,
+			"name":            logicalPort.Name,
+			"description":     logicalPort.Description,
+			"tenant_id":       logicalPort.TenantId,
+			"fabric_id":       logicalPort.FabricId,
+			"logic_switch_id": logicalPort.LogicSwitchId,
+			"producer":        producer,
+		})
+	}
+
+	return setDataSourceList(d, "logical_ports", ids, items)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileLogicalPorts(t *testing.T) {
+	dataSourceName := "data.agile_logical_ports.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileLogicalPorts,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "logical_ports.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "agile_logical_port.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logical_ports.0.id", "agile_logical_port.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logical_ports.0.name", "agile_logical_port.this", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileLogicalPorts = `
+resource "agile_logical_port" "this" {
+	name            = "tf_acc_tests_logical_ports"
+	description     = "Logical Port created via Terraform Tests"
+	tenant_id       = "11ade37a-79d0-482f-a7a0-6ad070e1d05d"
+	fabric_id       = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
+	logic_switch_id = "6c0a96d3-0789-47e6-9dbc-66ac5ba2e519"
+	access_info {
+		mode = "Uni"
+		type = "Dot1q"
+		vlan = 1219
+		location {
+			device_group_id = "e13784fb-499f-4c30-8f9c-e49e6c98fdbb"
+			device_id       = "9e3a5bee-3d95-3bf7-90f5-09bd2177324b"
+			port_id         = "589c87dd-7222-3c09-87b7-d09a236af285"
+		}
+	}
+	additional {
+		producer = "terraform"
+	}
+}
+
+data "agile_logical_ports" "this" {
+ name_regex      = "^${agile_logical_port.this.name}$"
+ tenant_id       = agile_logical_port.this.tenant_id
+ logic_switch_id = agile_logical_port.this.logic_switch_id
+ producer        = "terraform"
+}
+`
@@ -14,13 +14,15 @@ import (
 
 func dataSourceAgileLogicalRouter() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source can be used to retrieve Logical Router by name.",
+		Description: "Data source can be used to retrieve Logical Router by name or ID. The name lookup can be scoped with `logic_network_id`.",
 		ReadContext: dataSourceAgileLogicalRouterRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Logical router name.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Logical router name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.All(
 						validation.StringLenBetween(1, 255),
@@ -29,9 +31,11 @@ func dataSourceAgileLogicalRouter() *schema.Resource {
 				),
 			},
 			"id": {
-				Description: "Logical router ID.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "Logical router ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"description": {
 				Description: "Logical router description.",
@@ -39,9 +43,11 @@ func dataSourceAgileLogicalRouter() *schema.Resource {
 				Computed:    true,
 			},
 			"logic_network_id": {
-				Type:        schema.TypeString,
-				Description: "Logical network where a logical router is located.",
-				Computed:    true,
+				Type:         schema.TypeString,
+				Description:  "Logical network where a logical router is located. When set, only objects belonging to it are looked up.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"type": {
 				Description: "Logical router type, which can be Normal, Nfvi, MultiActive, Transit, or Connect. This field cannot be updated.",
@@ -60,7 +66,7 @@ func dataSourceAgileLogicalRouter() *schema.Resource {
 			},
 			"router_locations": {
 				Type:        schema.TypeSet,
-				Description: "Router Locations Settings.",
+				Description: "Router Locations Settings, the master location and its backups.",
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -101,31 +107,61 @@ func dataSourceAgileLogicalRouter() *schema.Resource {
 					},
 				},
 			},
+			"additional": {
+				Type:        schema.TypeList,
+				Description: "Additional Settings.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"producer": {
+							Type:        schema.TypeString,
+							Description: "Producer of the logical router.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceAgileLogicalRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get("name").(string)
-	log.Printf("[DEBUG] %s: Beginning Read", name)
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
-	logicalRouters, err := agileClient.ListLogicalRouters(nil)
+	// An id lookup reads the object directly instead of walking every page, the other
+	// arguments are still checked against it below.
+	var logicalRouters []models.LogicalRouter
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		var found *models.LogicalRouter
+		if found, err = getLogicalRouter(agileMeta, id.(string)); err == nil {
+			logicalRouters = []models.LogicalRouter{*found}
+		}
+	} else {
+		logicalRouters, err = listAllLogicalRouters(agileMeta, agile.LogicalRouterRequestOpts{
+			Name:           d.Get("name").(string),
+			LogicNetworkId: d.Get("logic_network_id").(string),
+		})
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var logicalRouter models.LogicalRouter
-	underscore.Chain(logicalRouters).Find(func(l models.LogicalRouter, _ int) bool {
-		return *l.Name == name
-	}).Value(&logicalRouter)
+	var matches []models.LogicalRouter
+	underscore.Chain(logicalRouters).Filter(func(l models.LogicalRouter, _ int) bool {
+		return filter.match("id", l.Id) && filter.match("name", l.Name) && filter.match("logic_network_id", l.LogicNetworkId)
+	}).Value(&matches)
 
-	if logicalRouter.Id == nil || *logicalRouter.Id == "" {
-		return diag.Errorf("No Logical router with name %s found", name)
+	if err := filter.lookupError("Logical router", len(matches), "logic_network_id"); err != nil {
+		return err
 	}
 
+	logicalRouter := matches[0]
+
 	d.SetId(*logicalRouter.Id)
 	d.Set("name", logicalRouter.Name)
 	d.Set("description", *logicalRouter.Description)
@@ -134,22 +170,17 @@ func dataSourceAgileLogicalRouterRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("vrf_name", *logicalRouter.VrfName)
 	d.Set("type", *logicalRouter.Type)
 
-	var routerLocations []interface{}
-	var deviceGroups []interface{}
-	for _, deviceGroup := range logicalRouter.RouterLocations[0].DeviceGroup {
-		deviceGroups = append(deviceGroups, map[string]interface{}{
-			"device_id": *deviceGroup.DeviceId,
-			"device_ip": *deviceGroup.DeviceIp,
-		})
-	}
+	d.Set("router_locations", flattenLogicalRouterLocations(logicalRouter.RouterLocations))
 
-	routerLocations = append(routerLocations, map[string]interface{}{
-		"fabric_role":  *logicalRouter.RouterLocations[0].FabricRole,
-		"fabric_id":    *logicalRouter.RouterLocations[0].FabricId,
-		"fabric_name":  *logicalRouter.RouterLocations[0].FabricName,
-		"device_group": deviceGroups,
-	})
+	if logicalRouter.Additional != nil {
+		if err := d.Set("additional", []interface{}{
+			map[string]*string{
+				"producer": logicalRouter.Additional.Producer,
+			},
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	d.Set("router_locations", routerLocations)
 	return nil
 }
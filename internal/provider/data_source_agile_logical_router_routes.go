@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+)
+
+func dataSourceAgileLogicalRouterRoutes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the effective routing table of a Logical Router on each device of its `router_locations`. " +
+			"Routes are read from the devices and are never cached.",
+		ReadContext: dataSourceAgileLogicalRouterRoutesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Logical router ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_router_id": {
+				Description:  "Logical router to read the routes of.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"device_id": {
+				Description:  "When set, only the routes of this device are read.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"protocol": {
+				Description: "When set, only the routes learnt through this protocol, such as Direct, Static or BGP, are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"routes": {
+				Description: "Routes of the logical router.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Description: "Device the route is installed on.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"device_ip": {
+							Description: "Management IP address of the device.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"destination": {
+							Description: "Destination of the route, in CIDR notation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"next_hop": {
+							Description: "Next hop IP address.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"protocol": {
+							Description: "Protocol the route was learnt through.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"out_interface": {
+							Description: "Outgoing interface.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgileLogicalRouterRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	logicRouterId := d.Get("logic_router_id").(string)
+	log.Printf("[DEBUG] %s: Beginning Read of routes", logicRouterId)
+
+	agileMeta := meta.(*providerMeta)
+
+	logicalRouter, err := getLogicalRouter(agileMeta, logicRouterId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deviceId := d.Get("device_id").(string)
+	protocol := d.Get("protocol").(string)
+
+	// A device can appear in several router locations, its routes are only read once.
+	seen := make(map[string]bool)
+	routes := make([]interface{}, 0)
+	for _, location := range logicalRouter.RouterLocations {
+		for _, device := range location.DeviceGroup {
+			if device.DeviceId == nil || (deviceId != "" && *device.DeviceId != deviceId) || seen[*device.DeviceId] {
+				continue
+			}
+			seen[*device.DeviceId] = true
+
+			deviceRoutes, err := agileMeta.client.ListLogicalRouterRoutes(logicRouterId, *device.DeviceId)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			for _, route := range deviceRoutes {
+				if protocol != "" && (route.Protocol == nil || *route.Protocol != protocol) {
+					continue
+				}
+
+				routes = append(routes, map[string]interface{}{
+					"device_id":     device.DeviceId,
+					"device_ip":     device.DeviceIp,
+					"destination":   route.Destination,
+					"next_hop":      route.NextHop,
+					"protocol":      route.Protocol,
+					"out_interface": route.OutInterface,
+				})
+			}
+		}
+	}
+
+	d.SetId(logicRouterId)
+	if err := d.Set("routes", routes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileLogicalRouterRoutes(t *testing.T) {
+	dataSourceName := "data.agile_logical_router_routes.this"
+	resourceName := "agile_logical_router.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileLogicalRouterRoutes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "routes.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileLogicalRouterRoutes = `
+resource "agile_logical_router" "this" {
+	name             = "tf_acc_tests_logical_router_routes"
+	description      = "Logical Router created via Terraform Tests"
+	logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+	type             = "Normal"
+	vrf_name         = "tf_acc_routes"
+	router_locations {
+		fabric_id   = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
+		fabric_role = "master"
+		device_group {
+			device_id = "9e3a5bee-3d95-3bf7-90f5-09bd2177324b"
+		}
+	}
+}
+
+data "agile_logical_router_routes" "this" {
+	logic_router_id = agile_logical_router.this.id
+	protocol        = "Direct"
+}
+`
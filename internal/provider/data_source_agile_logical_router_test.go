@@ -21,6 +21,8 @@ func TestAccDataSourceAgileLogicalRouter(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "logic_network_id", resourceName, "logic_network_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "type", resourceName, "type"),
 					resource.TestCheckResourceAttr(resourceName, "router_locations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "router_locations.#", resourceName, "router_locations.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "additional.0.producer", resourceName, "additional.0.producer"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "router_locations.0.fabric_role", resourceName, "router_locations.0.fabric_role"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "router_locations.0.fabric_id", resourceName, "router_locations.0.fabric_id"),
 				),
@@ -41,6 +43,9 @@ resource "agile_logical_router" "this" {
 		fabric_id   = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
 		fabric_role = "master"
 	}
+	additional {
+		producer = "Terraform"
+	}
 }
 
 data "agile_logical_router" "this" {
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileLogicalRouters() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of Logical Routers matching the given filters.",
+		ReadContext: dataSourceAgileLogicalRoutersRead,
+		Schema: dataSourceListSchema("logical_routers", "Matching logical routers.", map[string]*schema.Schema{
+			"id": {
+				Description: "Logical router ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Logical router name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Logical router description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_network_id": {
+				Description: "Logical network where a logical router is located.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "Logical router type, which can be Normal, Nfvi, MultiActive, Transit, or Connect.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"vni": {
+				Description: "Logical router VNI.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"vrf_name": {
+				Description: "VRF name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}, "name_regex", "logic_network_id"),
+	}
+}
+
+func dataSourceAgileLogicalRoutersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Logical Routers: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	logicalRouters, err := listAllLogicalRouters(agileMeta, agile.LogicalRouterRequestOpts{
+		LogicNetworkId: d.Get("logic_network_id").(string),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, logicalRouter := range logicalRouters {
+		if !filter.matchName(logicalRouter.Name) || !filter.match("logic_network_id", logicalRouter.LogicNetworkId) {
+			continue
+		}
+
+		ids = append(ids, *logicalRouter.Id)
+		items = append(items, map[string]interface{}{
+			"id":               logicalRouter.Id,
+			"name":             logicalRouter.Name,
+			"description":      logicalRouter.Description,
+			"logic_network_id": logicalRouter.LogicNetworkId,
+			"type":             logicalRouter.Type,
+			"vni":              logicalRouter.Vni,
+			"vrf_name":         logicalRouter.VrfName,
+		})
+	}
+
+	return setDataSourceList(d, "logical_routers", ids, items)
+}
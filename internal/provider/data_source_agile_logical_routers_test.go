@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileLogicalRouters(t *testing.T) {
+	dataSourceName := "data.agile_logical_routers.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileLogicalRouters,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "logical_routers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "agile_logical_router.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logical_routers.0.id", "agile_logical_router.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logical_routers.0.name", "agile_logical_router.this", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileLogicalRouters = `
+resource "agile_logical_router" "this" {
+	name             = "tf_acc_tests_logicalRouters"
+	description      = "Logical Router created via Terraform Tests"
+	type             = "Normal"
+    logic_network_id = "acfd8aaf-c6dc-499d-8020-bebd85b1f0e6"
+    vrf_name         = "tf_acc_tests_logicalRouters_4227"
+    vni              = 4227
+	router_locations {
+		fabric_id   = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
+		fabric_role = "master"
+	}
+}
+
+data "agile_logical_routers" "this" {
+ name_regex       = "^${agile_logical_router.this.name}$"
+ logic_network_id = agile_logical_router.this.logic_network_id
+}
+`
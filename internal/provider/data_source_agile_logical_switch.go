@@ -15,13 +15,15 @@ import (
 
 func dataSourceAgileLogicalSwitch() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source can be used to retrieve Logical Switch by name.",
+		Description: "Data source can be used to retrieve Logical Switch by name or ID. The name lookup can be scoped with `tenant_id` and `logic_network_id`.",
 		ReadContext: dataSourceAgileLogicalSwitchRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Logical switch name.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Logical switch name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.All(
 						validation.StringLenBetween(1, 255),
@@ -30,9 +32,11 @@ func dataSourceAgileLogicalSwitch() *schema.Resource {
 				),
 			},
 			"id": {
-				Description: "Logical Switch ID.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "Logical Switch ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"description": {
 				Description: "Logical switch description.",
@@ -40,9 +44,11 @@ func dataSourceAgileLogicalSwitch() *schema.Resource {
 				Computed:    true,
 			},
 			"logic_network_id": {
-				Type:        schema.TypeString,
-				Description: "Logical network where a logical switch is located.",
-				Computed:    true,
+				Type:         schema.TypeString,
+				Description:  "Logical network where a logical switch is located. When set, only objects belonging to it are looked up.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"bd": {
 				Description: "BD ID of a logical switch.",
@@ -60,8 +66,15 @@ func dataSourceAgileLogicalSwitch() *schema.Resource {
 				Computed:    true,
 			},
 			"tenant_id": {
+				Type:         schema.TypeString,
+				Description:  "Tenant ID. When set, only objects belonging to it are looked up.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"qos_policy_id": {
 				Type:        schema.TypeString,
-				Description: "Tenant ID. In the northbound direction, the value can be either specified or not. The controller can automatically obtain the tenant ID from a logical network.",
+				Description: "QoS policy applied to the logical switch.",
 				Computed:    true,
 			},
 			"storm_suppress": {
@@ -167,26 +180,43 @@ func dataSourceAgileLogicalSwitch() *schema.Resource {
 }
 
 func dataSourceAgileLogicalSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get("name").(string)
-	log.Printf("[DEBUG] %s: Beginning Read", name)
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
-	logicalSwitches, err := agileClient.ListLogicalSwitches(nil)
+	// An id lookup reads the object directly instead of walking every page, the other
+	// arguments are still checked against it below.
+	var logicalSwitches []models.LogicalSwitch
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		var found *models.LogicalSwitch
+		if found, err = getLogicalSwitch(agileMeta, id.(string)); err == nil {
+			logicalSwitches = []models.LogicalSwitch{*found}
+		}
+	} else {
+		logicalSwitches, err = listAllLogicalSwitches(agileMeta, agile.LogicalSwitchRequestOpts{
+			Name:           d.Get("name").(string),
+			TenantId:       d.Get("tenant_id").(string),
+			LogicNetworkId: d.Get("logic_network_id").(string),
+		})
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var logicalSwitch models.LogicalSwitch
-	underscore.Chain(logicalSwitches).Find(func(l models.LogicalSwitch, _ int) bool {
-		return *l.Name == name
-	}).Value(&logicalSwitch)
+	var matches []models.LogicalSwitch
+	underscore.Chain(logicalSwitches).Filter(func(l models.LogicalSwitch, _ int) bool {
+		return filter.match("id", l.Id) && filter.match("name", l.Name) && filter.match("tenant_id", l.TenantId) && filter.match("logic_network_id", l.LogicNetworkId)
+	}).Value(&matches)
 
-	if logicalSwitch.Id == nil || *logicalSwitch.Id == "" {
-		return diag.Errorf("No Logical switch with name %s found", name)
+	if err := filter.lookupError("Logical switch", len(matches), "tenant_id", "logic_network_id"); err != nil {
+		return err
 	}
 
+	logicalSwitch := matches[0]
+
 	d.SetId(*logicalSwitch.Id)
 	if err := d.Set("name", logicalSwitch.Name); err != nil {
 		return diag.FromErr(err)
@@ -216,6 +246,10 @@ func dataSourceAgileLogicalSwitchRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("qos_policy_id", logicalSwitch.QosPolicyId); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if logicalSwitch.Additional != nil {
 		if err := d.Set("additional", []interface{}{
 			map[string]*string{
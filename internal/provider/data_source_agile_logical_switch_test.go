@@ -2,6 +2,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
 	"testing"
 )
 
@@ -43,3 +44,67 @@ data "agile_logical_switch" "this" {
  name = agile_logical_switch.this.name
 }
 `
+
+func TestAccDataSourceAgileLogicalSwitch_ById(t *testing.T) {
+	dataSourceName := "data.agile_logical_switch.this"
+	resourceName := "agile_logical_switch.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileLogicalSwitchById,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logic_network_id", resourceName, "logic_network_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAgileLogicalSwitch_Ambiguous(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAgileLogicalSwitchAmbiguous,
+				ExpectError: regexp.MustCompile("set id, tenant_id, logic_network_id to select one"),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileLogicalSwitchById = `
+resource "agile_logical_switch" "this" {
+	name             = "tf_acc_tests_logical_switch_by_id"
+	description      = "Logical Switch created via Terraform Tests"
+    tenant_id        = "cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"
+	logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+}
+
+data "agile_logical_switch" "this" {
+ id = agile_logical_switch.this.id
+}
+`
+
+const testAccDataSourceAgileLogicalSwitchAmbiguous = `
+resource "agile_logical_switch" "first" {
+	name             = "tf_acc_tests_logical_switch_ambiguous"
+    tenant_id        = "cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"
+	logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+}
+
+resource "agile_logical_switch" "second" {
+	name             = "tf_acc_tests_logical_switch_ambiguous"
+    tenant_id        = "cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"
+	logic_network_id = "acfd8aaf-c6dc-499d-8020-bebd85b1f0e6"
+}
+
+data "agile_logical_switch" "this" {
+ name       = agile_logical_switch.first.name
+ depends_on = [agile_logical_switch.second]
+}
+`
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileLogicalSwitches() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of Logical Switches matching the given filters.",
+		ReadContext: dataSourceAgileLogicalSwitchesRead,
+		Schema: dataSourceListSchema("logical_switches", "Matching logical switches.", map[string]*schema.Schema{
+			"id": {
+				Description: "Logical switch ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Logical switch name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Logical switch description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tenant_id": {
+				Description: "Tenant ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logic_network_id": {
+				Description: "Logical network where a logical switch is located.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"vni": {
+				Description: "Logical switch VNI.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"bd": {
+				Description: "Logical switch BD.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"mac_address": {
+				Description: "Logical switch MAC address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"producer": {
+				Description: "Producer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}, "name_regex", "tenant_id", "logic_network_id", "producer"),
+	}
+}
+
+func dataSourceAgileLogicalSwitchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Logical Switches: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	logicalSwitches, err := listAllLogicalSwitches(agileMeta, agile.LogicalSwitchRequestOpts{
+		TenantId:       d.Get("tenant_id").(string),
+		LogicNetworkId: d.Get("logic_network_id").(string),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, logicalSwitch := range logicalSwitches {
+		var producer *string
+		if logicalSwitch.Additional != nil {
+			producer = logicalSwitch.Additional.Producer
+		}
+
+		if !filter.matchName(logicalSwitch.Name) || !filter.match("tenant_id", logicalSwitch.TenantId) ||
+			!filter.match("logic_network_id", logicalSwitch.LogicNetworkId) || !filter.match("producer", producer) {
+			continue
+		}
+
+		ids = append(ids, *logicalSwitch.Id)
+		items = append(items, map[string]interface{}{
+			"id":               logicalSwitch.Id,
+			"name":             logicalSwitch.Name,
+			"description":      logicalSwitch.Description,
+			"tenant_id":        logicalSwitch.TenantId,
+			"logic_network_id": logicalSwitch.LogicNetworkId,
+			"vni":              logicalSwitch.Vni,
+			"bd":               logicalSwitch.Bd,
+			"mac_address":      logicalSwitch.MacAddress,
+			"producer":         producer,
+		})
+	}
+
+	return setDataSourceList(d, "logical_switches", ids, items)
+}
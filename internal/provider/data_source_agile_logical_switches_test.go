@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileLogicalSwitches(t *testing.T) {
+	dataSourceName := "data.agile_logical_switches.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileLogicalSwitches,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "logical_switches.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "agile_logical_switch.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logical_switches.0.id", "agile_logical_switch.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "logical_switches.0.name", "agile_logical_switch.this", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileLogicalSwitches = `
+resource "agile_logical_switch" "this" {
+	name             = "tf_acc_tests_logical_switches"
+	description      = "Logical Switch created via Terraform Tests"
+    tenant_id        = "cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"
+	logic_network_id = "5308df55-1709-404f-b4f8-4d8947d8f0c4"
+	additional {
+		producer = "terraform"
+	}
+}
+
+data "agile_logical_switches" "this" {
+ name_regex       = "^${agile_logical_switch.this.name}$"
+ tenant_id        = agile_logical_switch.this.tenant_id
+ logic_network_id = agile_logical_switch.this.logic_network_id
+ producer         = "terraform"
+}
+`
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	underscore "github.com/ahl5esoft/golang-underscore"
 	agile "github.com/claranet/agilec-go-client/client"
 	"github.com/claranet/agilec-go-client/models"
@@ -9,17 +10,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
+	"terraform-provider-agile/tools"
 )
 
 func dataSourceAgileTenant() *schema.Resource {
 	return &schema.Resource{
-		Description: "Data source can be used to retrieve Tenant by name.",
+		Description: "Data source can be used to retrieve Tenant by name or ID.",
 		ReadContext: dataSourceAgileTenantRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Description: "Tenant name.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Tenant name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
 				ValidateDiagFunc: validation.ToDiagFunc(
 					validation.All(
 						validation.StringLenBetween(1, 255),
@@ -28,9 +32,11 @@ func dataSourceAgileTenant() *schema.Resource {
 				),
 			},
 			"id": {
-				Description: "Tenant ID.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "Tenant ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 			"description": {
 				Description: "Tenant description.",
@@ -47,35 +53,274 @@ func dataSourceAgileTenant() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"quota": {
+				Description: "Quota of logical objects the tenant can create.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logic_vas_num": {
+							Description: "Maximum number of logical VASs that can be created for the tenant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"logic_router_num": {
+							Description: "Maximum number of logical routers that can be created for the tenant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"logic_switch_num": {
+							Description: "Maximum number of logical switches that can be created for the tenant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"multicast_quota": {
+				Description: "Quota of ACLs the tenant can create when the multicast capability is enabled.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"acl_num": {
+							Description: "Maximum number of ACLs that can be created for the tenant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"acl_rule_num": {
+							Description: "Maximum number of ACL rules that can be created for the tenant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"res_pool": {
+				Description: "Resources the tenant can use.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_gateway_ids": {
+							Description: "External gateways that can be used by the tenant.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"fabric_ids": {
+							Description: "Fabrics that can be used by the tenant.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"vmm_ids": {
+							Description: "VMMs that can be used by the tenant.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"dhcp_group_ids": {
+							Description: "DHCP groups that can be used by the tenant.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"usage": {
+				Description: "Number of logical objects currently created for the tenant, to be compared with `quota`. Left empty, with a warning, when it cannot be collected.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logic_vas_num": {
+							Description: "Number of logical VASs created for the tenant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"logic_router_num": {
+							Description: "Number of logical routers created for the tenant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"logic_switch_num": {
+							Description: "Number of logical switches created for the tenant.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourceAgileTenantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get("name").(string)
-	log.Printf("[DEBUG] %s: Beginning Read", name)
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
-	tenants, err := agileClient.ListTenants(nil)
+	// An id lookup reads the object directly instead of walking every page, the other
+	// arguments are still checked against it below.
+	var tenants []models.Tenant
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		var found *models.Tenant
+		if found, err = getTenant(agileMeta, id.(string)); err == nil {
+			tenants = []models.Tenant{*found}
+		}
+	} else {
+		tenants, err = listAllTenants(agileMeta, agile.TenantRequestOpts{Name: d.Get("name").(string)})
+	}
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var tenant models.Tenant
-	underscore.Chain(tenants).Find(func(t models.Tenant, _ int) bool {
-		return *t.Name == name
-	}).Value(&tenant)
+	var matches []models.Tenant
+	underscore.Chain(tenants).Filter(func(t models.Tenant, _ int) bool {
+		return filter.match("id", t.Id) && filter.match("name", t.Name)
+	}).Value(&matches)
 
-	if tenant.Id == nil || *tenant.Id == "" {
-		return diag.Errorf("No Tenant with name %s found", name)
+	if err := filter.lookupError("Tenant", len(matches)); err != nil {
+		return err
 	}
 
+	tenant := matches[0]
+
 	d.SetId(*tenant.Id)
 	d.Set("name", tenant.Name)
 	d.Set("description", *tenant.Description)
 	d.Set("producer", *tenant.Producer)
 	d.Set("multicast_capability", *tenant.MulticastCapability)
-	return nil
+
+	quota := make([]interface{}, 0)
+	if tenant.Quota != nil {
+		quota = append(quota, map[string]interface{}{
+			"logic_vas_num":    tenant.Quota.LogicVasNum,
+			"logic_router_num": tenant.Quota.LogicRouterNum,
+			"logic_switch_num": tenant.Quota.LogicSwitchNum,
+		})
+	}
+	if err := d.Set("quota", quota); err != nil {
+		return diag.FromErr(err)
+	}
+
+	multicastQuota := make([]interface{}, 0)
+	if tenant.MulticastQuota != nil {
+		multicastQuota = append(multicastQuota, map[string]interface{}{
+			"acl_num":      tenant.MulticastQuota.AclNum,
+			"acl_rule_num": tenant.MulticastQuota.AclRuleNum,
+		})
+	}
+	if err := d.Set("multicast_quota", multicastQuota); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resPool := make([]interface{}, 0)
+	if tenant.ResPool != nil {
+		resPool = append(resPool, map[string]interface{}{
+			"external_gateway_ids": tools.CreateSliceOfStrings(tenant.ResPool.ExternalGatewayIds),
+			"fabric_ids":           tools.CreateSliceOfStrings(tenant.ResPool.FabricIds),
+			"vmm_ids":              tools.CreateSliceOfStrings(tenant.ResPool.VmmIds),
+			"dhcp_group_ids":       tools.CreateSliceOfStrings(tenant.ResPool.DhcpGroupIds),
+		})
+	}
+	if err := d.Set("res_pool", resPool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Usage needs extra list calls, failing to collect it must not break the tenant lookup.
+	var diags diag.Diagnostics
+	usage, err := tenantUsage(agileMeta, *tenant.Id)
+	if err != nil {
+		usage = []interface{}{}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Tenant usage could not be collected",
+			Detail:   fmt.Sprintf("usage of tenant %s is left empty: %s", *tenant.Id, err),
+		})
+	}
+	if err := d.Set("usage", usage); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return diags
+}
+
+// tenantUsage counts the logical VASs, routers and switches created for the tenant. The tenant
+// is checked again on every object, routers through the tenant logical networks they belong to.
+func tenantUsage(agileMeta *providerMeta, tenantId string) ([]interface{}, error) {
+	belongs := func(id *string) bool {
+		return id != nil && *id == tenantId
+	}
+
+	logicalVas, err := listAllLogicalVas(agileMeta, agile.LogicalVasRequestOpts{TenantId: tenantId})
+	if err != nil {
+		return nil, err
+	}
+
+	logicVasNum := 0
+	for _, vas := range logicalVas {
+		if belongs(vas.TenantId) {
+			logicVasNum++
+		}
+	}
+
+	logicalNetworks, err := listAllLogicalNetworks(agileMeta, agile.LogicalNetworkRequestOpts{TenantId: tenantId})
+	if err != nil {
+		return nil, err
+	}
+
+	tenantNetworks := make(map[string]bool)
+	for _, logicalNetwork := range logicalNetworks {
+		if logicalNetwork.Id != nil && belongs(logicalNetwork.TenantId) {
+			tenantNetworks[*logicalNetwork.Id] = true
+		}
+	}
+
+	logicalRouters, err := listAllLogicalRouters(agileMeta, agile.LogicalRouterRequestOpts{TenantId: tenantId})
+	if err != nil {
+		return nil, err
+	}
+
+	logicRouterNum := 0
+	for _, logicalRouter := range logicalRouters {
+		if logicalRouter.LogicNetworkId != nil && tenantNetworks[*logicalRouter.LogicNetworkId] {
+			logicRouterNum++
+		}
+	}
+
+	logicalSwitches, err := listAllLogicalSwitches(agileMeta, agile.LogicalSwitchRequestOpts{TenantId: tenantId})
+	if err != nil {
+		return nil, err
+	}
+
+	logicSwitchNum := 0
+	for _, logicalSwitch := range logicalSwitches {
+		if belongs(logicalSwitch.TenantId) {
+			logicSwitchNum++
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"logic_vas_num":    logicVasNum,
+			"logic_router_num": logicRouterNum,
+			"logic_switch_num": logicSwitchNum,
+		},
+	}, nil
 }
@@ -21,6 +21,12 @@ func TestAccDataSourceAgileTenant(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "producer", resourceName, "producer"),
 					resource.TestCheckResourceAttr(resourceName, "multicast_capability", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "quota.0.logic_vas_num", resourceName, "quota.0.logic_vas_num"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "quota.0.logic_router_num", resourceName, "quota.0.logic_router_num"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "quota.0.logic_switch_num", resourceName, "quota.0.logic_switch_num"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage.0.logic_vas_num", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage.0.logic_router_num", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "usage.0.logic_switch_num", "0"),
 				),
 			},
 		},
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+)
+
+func dataSourceAgileTenants() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve the list of Tenants matching the given filters.",
+		ReadContext: dataSourceAgileTenantsRead,
+		Schema: dataSourceListSchema("tenants", "Matching tenants.", map[string]*schema.Schema{
+			"id": {
+				Description: "Tenant ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Tenant name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Tenant description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"producer": {
+				Description: "Producer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"multicast_capability": {
+				Description: "Whether the multicast capability is supported.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		}, "name_regex", "producer"),
+	}
+}
+
+func dataSourceAgileTenantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Tenants: Beginning Read")
+
+	agileMeta := meta.(*providerMeta)
+
+	tenants, err := listAllTenants(agileMeta, agile.TenantRequestOpts{})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := newDataSourceFilter(d)
+
+	ids := make([]string, 0)
+	items := make([]interface{}, 0)
+	for _, tenant := range tenants {
+		if !filter.matchName(tenant.Name) || !filter.match("producer", tenant.Producer) {
+			continue
+		}
+
+		ids = append(ids, *tenant.Id)
+		items = append(items, map[string]interface{}{
+			"id":                   tenant.Id,
+			"name":                 tenant.Name,
+			"description":          tenant.Description,
+			"producer":             tenant.Producer,
+			"multicast_capability": tenant.MulticastCapability,
+		})
+	}
+
+	return setDataSourceList(d, "tenants", ids, items)
+}
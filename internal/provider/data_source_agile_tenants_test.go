@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAgileTenants(t *testing.T) {
+	dataSourceName := "data.agile_tenants.this"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAgileTenants,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tenants.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "agile_tenant.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tenants.0.id", "agile_tenant.this", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tenants.0.name", "agile_tenant.this", "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAgileTenants = `
+resource "agile_tenant" "this" {
+	name  = "tf_acc_tests_tenants"
+	producer = "terraform_producer"
+    description = "terraform acceptance tests"
+	quota {
+		logic_vas_num = "10"
+		logic_router_num = "15"
+		logic_switch_num = "18"
+	}
+}
+
+data "agile_tenants" "this" {
+ name_regex = "^${agile_tenant.this.name}$"
+ producer   = agile_tenant.this.producer
+}
+`
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	underscore "github.com/ahl5esoft/golang-underscore"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"terraform-provider-agile/tools"
+)
+
+func dataSourceAgileVmm() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source can be used to retrieve VMM (virtual machine manager) by name or ID, for instance to fill `res_pool.vmm_ids` of `agile_tenant` in compute integration scenarios.",
+		ReadContext: dataSourceAgileVmmRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "VMM name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name"},
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 255),
+				),
+			},
+			"id": {
+				Description:  "VMM ID.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"description": {
+				Description: "VMM description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "VMM type, such as vCenter, OpenStack or System Center.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"endpoint": {
+				Description: "Address of the VMM API.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"fabric_ids": {
+				Description: "Fabrics associated with the VMM.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"dvs": {
+				Description: "Distributed virtual switches managed by the VMM.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "DVS ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "DVS name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"fabric_id": {
+							Description: "Fabric the DVS is connected to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgileVmmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filter := newDataSourceFilter(d)
+	log.Printf("[DEBUG] %s: Beginning Read", filter.lookupCriteria())
+
+	agileMeta := meta.(*providerMeta)
+
+	vmms, err := listAllVmms(agileMeta, agile.VmmRequestOpts{Name: d.Get("name").(string)})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var matches []models.Vmm
+	underscore.Chain(vmms).Filter(func(v models.Vmm, _ int) bool {
+		return filter.match("id", v.Id) && filter.match("name", v.Name)
+	}).Value(&matches)
+
+	if err := filter.lookupError("VMM", len(matches)); err != nil {
+		return err
+	}
+
+	vmm := matches[0]
+
+	d.SetId(*vmm.Id)
+	if err := d.Set("name", vmm.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("description", vmm.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("type", vmm.Type); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("endpoint", vmm.Endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("fabric_ids", tools.CreateSliceOfStrings(vmm.FabricIds)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	dvs := make([]interface{}, 0)
+	for _, item := range vmm.Dvs {
+		dvs = append(dvs, map[string]interface{}{
+			"id":        item.Id,
+			"name":      item.Name,
+			"fabric_id": item.FabricId,
+		})
+	}
+	if err := d.Set("dvs", dvs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"github.com/claranet/agilec-go-client/models"
+)
+
+// getAcl returns the Acl with id, through the provider cache.
+func getAcl(agileMeta *providerMeta, id string) (*models.Acl, error) {
+	acl, err := agileMeta.cache.get("Acl "+id, func() (interface{}, error) {
+		return agileMeta.client.GetAcl(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return acl.(*models.Acl), nil
+}
+
+// getDevice returns the Device with id, through the provider cache.
+func getDevice(agileMeta *providerMeta, id string) (*models.Device, error) {
+	device, err := agileMeta.cache.get("Device "+id, func() (interface{}, error) {
+		return agileMeta.client.GetDevice(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return device.(*models.Device), nil
+}
+
+// getDeviceGroup returns the DeviceGroup with id, through the provider cache.
+func getDeviceGroup(agileMeta *providerMeta, id string) (*models.DeviceGroup, error) {
+	deviceGroup, err := agileMeta.cache.get("DeviceGroup "+id, func() (interface{}, error) {
+		return agileMeta.client.GetDeviceGroup(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deviceGroup.(*models.DeviceGroup), nil
+}
+
+// getDHCPRelay returns the DHCPRelay with id, through the provider cache.
+func getDHCPRelay(agileMeta *providerMeta, id string) (*models.DHCPRelay, error) {
+	dhcpRelay, err := agileMeta.cache.get("DHCPRelay "+id, func() (interface{}, error) {
+		return agileMeta.client.GetDHCPRelay(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dhcpRelay.(*models.DHCPRelay), nil
+}
+
+// getEndPort returns the EndPort with id, through the provider cache.
+func getEndPort(agileMeta *providerMeta, id string) (*models.EndPort, error) {
+	endPort, err := agileMeta.cache.get("EndPort "+id, func() (interface{}, error) {
+		return agileMeta.client.GetEndPort(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return endPort.(*models.EndPort), nil
+}
+
+// getFabricResourcePool returns the resource pool of the fabric with id, through the provider cache.
+func getFabricResourcePool(agileMeta *providerMeta, id string) (*models.FabricResourcePool, error) {
+	pool, err := agileMeta.cache.get("FabricResourcePool "+id, func() (interface{}, error) {
+		return agileMeta.client.GetFabricResourcePool(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pool.(*models.FabricResourcePool), nil
+}
+
+// getL2Bridge returns the L2Bridge with id, through the provider cache.
+func getL2Bridge(agileMeta *providerMeta, id string) (*models.L2Bridge, error) {
+	l2Bridge, err := agileMeta.cache.get("L2Bridge "+id, func() (interface{}, error) {
+		return agileMeta.client.GetL2Bridge(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return l2Bridge.(*models.L2Bridge), nil
+}
+
+// getLogicalNetwork returns the LogicalNetwork with id, through the provider cache.
+func getLogicalNetwork(agileMeta *providerMeta, id string) (*models.LogicalNetwork, error) {
+	logicalNetwork, err := agileMeta.cache.get("LogicalNetwork "+id, func() (interface{}, error) {
+		return agileMeta.client.GetLogicalNetwork(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logicalNetwork.(*models.LogicalNetwork), nil
+}
+
+// getLogicalPort returns the LogicalPort with id, through the provider cache.
+func getLogicalPort(agileMeta *providerMeta, id string) (*models.LogicalPort, error) {
+	logicalPort, err := agileMeta.cache.get("LogicalPort "+id, func() (interface{}, error) {
+		return agileMeta.client.GetLogicalPort(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logicalPort.(*models.LogicalPort), nil
+}
+
+// getLogicalRouter returns the LogicalRouter with id, through the provider cache.
+func getLogicalRouter(agileMeta *providerMeta, id string) (*models.LogicalRouter, error) {
+	logicalRouter, err := agileMeta.cache.get("LogicalRouter "+id, func() (interface{}, error) {
+		return agileMeta.client.GetLogicalRouter(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logicalRouter.(*models.LogicalRouter), nil
+}
+
+// getLogicalSwitch returns the LogicalSwitch with id, through the provider cache.
+func getLogicalSwitch(agileMeta *providerMeta, id string) (*models.LogicalSwitch, error) {
+	logicalSwitch, err := agileMeta.cache.get("LogicalSwitch "+id, func() (interface{}, error) {
+		return agileMeta.client.GetLogicalSwitch(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return logicalSwitch.(*models.LogicalSwitch), nil
+}
+
+// getMulticast returns the Multicast with id, through the provider cache.
+func getMulticast(agileMeta *providerMeta, id string) (*models.Multicast, error) {
+	multicast, err := agileMeta.cache.get("Multicast "+id, func() (interface{}, error) {
+		return agileMeta.client.GetMulticast(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return multicast.(*models.Multicast), nil
+}
+
+// getQosPolicy returns the QosPolicy with id, through the provider cache.
+func getQosPolicy(agileMeta *providerMeta, id string) (*models.QosPolicy, error) {
+	qosPolicy, err := agileMeta.cache.get("QosPolicy "+id, func() (interface{}, error) {
+		return agileMeta.client.GetQosPolicy(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return qosPolicy.(*models.QosPolicy), nil
+}
+
+// getTenant returns the Tenant with id, through the provider cache.
+func getTenant(agileMeta *providerMeta, id string) (*models.Tenant, error) {
+	tenant, err := agileMeta.cache.get("Tenant "+id, func() (interface{}, error) {
+		return agileMeta.client.GetTenant(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tenant.(*models.Tenant), nil
+}
+
+// getVpcConnection returns the VpcConnection with id, through the provider cache.
+func getVpcConnection(agileMeta *providerMeta, id string) (*models.VpcConnection, error) {
+	vpcConnection, err := agileMeta.cache.get("VpcConnection "+id, func() (interface{}, error) {
+		return agileMeta.client.GetVpcConnection(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vpcConnection.(*models.VpcConnection), nil
+}
@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"fmt"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+)
+
+// listPageSize is the number of objects requested per page when walking list calls.
+// The controller truncates unpaginated list calls to its default page size.
+// Walked lists are kept in the provider cache, keyed by their filters.
+const listPageSize = 1000
+
+// listMaxPages bounds the pages walked by a single list call, so that a controller ignoring
+// pageIndex and returning the same page again fails instead of looping forever.
+const listMaxPages = 1000
+
+// walkPages calls fetchPage until it returns an empty page. Controllers may cap the page size
+// below listPageSize, so a page shorter than requested is not necessarily the last one.
+func walkPages(fetchPage func() (int, error)) error {
+	for pages := 0; pages < listMaxPages; pages++ {
+		n, err := fetchPage()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("list did not end after %d pages, the controller may be ignoring pageIndex", listMaxPages)
+}
+
+// listTenantsPage fetches a single page of tenants, tests replace it to walk a fake controller.
+var listTenantsPage = (*agile.Client).ListTenants
+
+// listAllTenants walks every page of ListTenants matching opts.
+func listAllTenants(agileMeta *providerMeta, opts agile.TenantRequestOpts) ([]models.Tenant, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("Tenants %+v", opts), func() (interface{}, error) {
+		all := make([]models.Tenant, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := listTenantsPage(agileMeta.client, &opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.Tenant), nil
+}
+
+// listAllFabrics walks every page of ListFabrics matching opts.
+func listAllFabrics(agileMeta *providerMeta, opts agile.FabricRequestOpts) ([]models.Fabric, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("Fabrics %+v", opts), func() (interface{}, error) {
+		all := make([]models.Fabric, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListFabrics(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.Fabric), nil
+}
+
+// listAllExternalGateways walks every page of ListExternalGateways matching opts.
+func listAllExternalGateways(agileMeta *providerMeta, opts agile.ExternalGatewayRequestOpts) ([]models.ExternalGateway, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("ExternalGateways %+v", opts), func() (interface{}, error) {
+		all := make([]models.ExternalGateway, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListExternalGateways(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.ExternalGateway), nil
+}
+
+// listAllDHCPGroups walks every page of ListDHCPGroups matching opts.
+func listAllDHCPGroups(agileMeta *providerMeta, opts agile.DHCPGroupRequestOpts) ([]models.DHCPGroup, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("DHCPGroups %+v", opts), func() (interface{}, error) {
+		all := make([]models.DHCPGroup, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListDHCPGroups(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.DHCPGroup), nil
+}
+
+// listAllLogicalNetworks walks every page of ListLogicalNetworks matching opts.
+func listAllLogicalNetworks(agileMeta *providerMeta, opts agile.LogicalNetworkRequestOpts) ([]models.LogicalNetwork, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("LogicalNetworks %+v", opts), func() (interface{}, error) {
+		all := make([]models.LogicalNetwork, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListLogicalNetworks(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.LogicalNetwork), nil
+}
+
+// listAllLogicalRouters walks every page of ListLogicalRouters matching opts.
+func listAllLogicalRouters(agileMeta *providerMeta, opts agile.LogicalRouterRequestOpts) ([]models.LogicalRouter, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("LogicalRouters %+v", opts), func() (interface{}, error) {
+		all := make([]models.LogicalRouter, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListLogicalRouters(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.LogicalRouter), nil
+}
+
+// listAllLogicalSwitches walks every page of ListLogicalSwitches matching opts.
+func listAllLogicalSwitches(agileMeta *providerMeta, opts agile.LogicalSwitchRequestOpts) ([]models.LogicalSwitch, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("LogicalSwitches %+v", opts), func() (interface{}, error) {
+		all := make([]models.LogicalSwitch, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListLogicalSwitches(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.LogicalSwitch), nil
+}
+
+// listAllLogicalVas walks every page of ListLogicalVas matching opts.
+func listAllLogicalVas(agileMeta *providerMeta, opts agile.LogicalVasRequestOpts) ([]models.LogicalVas, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("LogicalVas %+v", opts), func() (interface{}, error) {
+		all := make([]models.LogicalVas, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListLogicalVas(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.LogicalVas), nil
+}
+
+// listAllLogicalPorts walks every page of ListLogicalPorts matching opts.
+func listAllLogicalPorts(agileMeta *providerMeta, opts agile.LogicalPortRequestOpts) ([]models.LogicalPort, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("LogicalPorts %+v", opts), func() (interface{}, error) {
+		all := make([]models.LogicalPort, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListLogicalPorts(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.LogicalPort), nil
+}
+
+// listAllEndPorts walks every page of ListEndPorts matching opts.
+func listAllEndPorts(agileMeta *providerMeta, opts agile.EndPortRequestOpts) ([]models.EndPort, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("EndPorts %+v", opts), func() (interface{}, error) {
+		all := make([]models.EndPort, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListEndPorts(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.EndPort), nil
+}
+
+// listAllDevices walks every page of ListDevices matching opts.
+func listAllDevices(agileMeta *providerMeta, opts agile.DeviceRequestOpts) ([]models.Device, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("Devices %+v", opts), func() (interface{}, error) {
+		all := make([]models.Device, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListDevices(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.Device), nil
+}
+
+// listAllAcls walks every page of ListAcls matching opts.
+func listAllAcls(agileMeta *providerMeta, opts agile.AclRequestOpts) ([]models.Acl, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("Acls %+v", opts), func() (interface{}, error) {
+		all := make([]models.Acl, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListAcls(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.Acl), nil
+}
+
+// listAllL2Bridges walks every page of ListL2Bridges matching opts.
+func listAllL2Bridges(agileMeta *providerMeta, opts agile.L2BridgeRequestOpts) ([]models.L2Bridge, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("L2Bridges %+v", opts), func() (interface{}, error) {
+		all := make([]models.L2Bridge, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListL2Bridges(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.L2Bridge), nil
+}
+
+// listAllVmms walks every page of ListVmms matching opts.
+func listAllVmms(agileMeta *providerMeta, opts agile.VmmRequestOpts) ([]models.Vmm, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("Vmms %+v", opts), func() (interface{}, error) {
+		all := make([]models.Vmm, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListVmms(&opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.Vmm), nil
+}
+
+// listAllAlarms walks every page of ListAlarms matching opts. Alarms are raised and cleared
+// continuously, so they are never read from the cache.
+func listAllAlarms(agileMeta *providerMeta, opts agile.AlarmRequestOpts) ([]models.Alarm, error) {
+	all := make([]models.Alarm, 0)
+	opts.PageSize = listPageSize
+	opts.PageIndex = 0
+	err := walkPages(func() (int, error) {
+		opts.PageIndex++
+		page, err := agileMeta.client.ListAlarms(&opts)
+		all = append(all, page...)
+		return len(page), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// listAllDevicePorts walks every page of ListDevicePorts of a device matching opts.
+func listAllDevicePorts(agileMeta *providerMeta, deviceId string, opts agile.DevicePortRequestOpts) ([]models.DevicePort, error) {
+	all, err := agileMeta.cache.get(fmt.Sprintf("DevicePorts %s %+v", deviceId, opts), func() (interface{}, error) {
+		all := make([]models.DevicePort, 0)
+		opts.PageSize = listPageSize
+		opts.PageIndex = 0
+		err := walkPages(func() (int, error) {
+			opts.PageIndex++
+			page, err := agileMeta.client.ListDevicePorts(deviceId, &opts)
+			all = append(all, page...)
+			return len(page), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return all, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.([]models.DevicePort), nil
+}
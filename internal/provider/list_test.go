@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+)
+
+type fakeListRequestOpts struct {
+	PageIndex int32
+	PageSize  int32
+}
+
+// fakeListController serves total objects and caps every page at maxPageSize, whatever the
+// requested page size.
+type fakeListController struct {
+	total       int
+	maxPageSize int
+	failOnPage  int32
+	calls       int
+}
+
+func (c *fakeListController) list(opts *fakeListRequestOpts) ([]int, error) {
+	c.calls++
+	if opts.PageIndex == c.failOnPage {
+		return nil, errors.New("controller unavailable")
+	}
+
+	size := int(opts.PageSize)
+	if size > c.maxPageSize {
+		size = c.maxPageSize
+	}
+
+	page := make([]int, 0)
+	for i := (int(opts.PageIndex) - 1) * size; i < int(opts.PageIndex)*size && i < c.total; i++ {
+		page = append(page, i)
+	}
+	return page, nil
+}
+
+func (c *fakeListController) listAll() ([]int, error) {
+	all := make([]int, 0)
+	opts := fakeListRequestOpts{PageSize: listPageSize}
+	err := walkPages(func() (int, error) {
+		opts.PageIndex++
+		page, err := c.list(&opts)
+		all = append(all, page...)
+		return len(page), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+func TestWalkPages(t *testing.T) {
+	cases := []struct {
+		name        string
+		total       int
+		maxPageSize int
+		wantCalls   int
+	}{
+		{"capped page size", 5000, 100, 51},
+		{"requested page size", 2500, listPageSize, 4},
+		{"exact multiple", 2000, listPageSize, 3},
+		{"empty", 0, 100, 1},
+	}
+
+	for _, c := range cases {
+		controller := &fakeListController{total: c.total, maxPageSize: c.maxPageSize}
+		all, err := controller.listAll()
+		if err != nil {
+			t.Fatalf("%s: unexpected error %s", c.name, err)
+		}
+
+		if len(all) != c.total {
+			t.Errorf("%s: got %d objects, want %d", c.name, len(all), c.total)
+		}
+
+		for i, v := range all {
+			if v != i {
+				t.Fatalf("%s: object %d is %d", c.name, i, v)
+			}
+		}
+
+		if controller.calls != c.wantCalls {
+			t.Errorf("%s: got %d list calls, want %d", c.name, controller.calls, c.wantCalls)
+		}
+	}
+}
+
+func TestWalkPagesError(t *testing.T) {
+	controller := &fakeListController{total: 5000, maxPageSize: 100, failOnPage: 3}
+	if _, err := controller.listAll(); err == nil {
+		t.Fatal("expected the error of the third page")
+	}
+
+	if controller.calls != 3 {
+		t.Errorf("got %d list calls, want 3", controller.calls)
+	}
+}
+
+func TestWalkPagesIgnoredPageIndex(t *testing.T) {
+	calls := 0
+	err := walkPages(func() (int, error) {
+		calls++
+		return listPageSize, nil
+	})
+
+	if err == nil {
+		t.Fatal("expected an error when the controller keeps returning pages")
+	}
+
+	if calls != listMaxPages {
+		t.Errorf("got %d list calls, want %d", calls, listMaxPages)
+	}
+}
+
+func TestListAllTenantsCappedPages(t *testing.T) {
+	original := listTenantsPage
+	defer func() { listTenantsPage = original }()
+
+	calls := 0
+	listTenantsPage = func(_ *agile.Client, opts *agile.TenantRequestOpts) ([]models.Tenant, error) {
+		calls++
+		if opts.Name != "web" {
+			t.Errorf("got name filter %q, want web", opts.Name)
+		}
+
+		page := make([]models.Tenant, 0)
+		for i := (int(opts.PageIndex) - 1) * 100; i < int(opts.PageIndex)*100 && i < 250; i++ {
+			page = append(page, models.Tenant{Id: agile.String(fmt.Sprint(i))})
+		}
+		return page, nil
+	}
+
+	agileMeta := &providerMeta{cache: newCache(time.Minute)}
+	for i := 0; i < 2; i++ {
+		tenants, err := listAllTenants(agileMeta, agile.TenantRequestOpts{Name: "web"})
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+
+		if len(tenants) != 250 || *tenants[249].Id != "249" {
+			t.Fatalf("got %d tenants, want 250", len(tenants))
+		}
+	}
+
+	if calls != 4 {
+		t.Errorf("got %d list calls, want 4 for the first walk and none for the cached one", calls)
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
 	"strings"
+	"time"
 )
 
 func init() {
@@ -60,15 +64,43 @@ func New(version string) func() *schema.Provider {
 						"`AGILE_INSECURE` environment variable.",
 					DefaultFunc: schema.EnvDefaultFunc("AGILE_INSECURE", false),
 				},
+				"cache_ttl": &schema.Schema{
+					Type:     schema.TypeInt,
+					Optional: true,
+					Description: "Number of seconds controller list and get responses are cached for, so that data sources and " +
+						"resources reading the same objects share API calls. The cache is cleared on every write. Set to `0` " +
+						"to disable caching. Defaults to `60`. Can be specified with the `AGILE_CACHE_TTL` environment variable.",
+					DefaultFunc:  schema.EnvDefaultFunc("AGILE_CACHE_TTL", 60),
+					ValidateFunc: validation.IntAtLeast(0),
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"agile_fabric":           dataSourceAgileFabric(),
-				"agile_external_gateway": dataSourceAgileExternalGateway(),
-				"agile_dhcp_group":       dataSourceAgileDhcpGroup(),
-				"agile_tenant":           dataSourceAgileTenant(),
-				"agile_logical_network":  dataSourceAgileLogicalNetwork(),
-				"agile_logical_router":   dataSourceAgileLogicalRouter(),
-				"agile_logical_switch":   dataSourceAgileLogicalSwitch(),
+				"agile_fabric":                dataSourceAgileFabric(),
+				"agile_external_gateway":      dataSourceAgileExternalGateway(),
+				"agile_dhcp_group":            dataSourceAgileDhcpGroup(),
+				"agile_tenant":                dataSourceAgileTenant(),
+				"agile_logical_network":       dataSourceAgileLogicalNetwork(),
+				"agile_logical_router":        dataSourceAgileLogicalRouter(),
+				"agile_logical_switch":        dataSourceAgileLogicalSwitch(),
+				"agile_device":                dataSourceAgileDevice(),
+				"agile_device_port":           dataSourceAgileDevicePort(),
+				"agile_logical_port":          dataSourceAgileLogicalPort(),
+				"agile_end_port":              dataSourceAgileEndPort(),
+				"agile_vmm":                   dataSourceAgileVmm(),
+				"agile_controller":            dataSourceAgileController(),
+				"agile_fabric_free_values":    dataSourceAgileFabricFreeValues(),
+				"agile_logical_router_routes": dataSourceAgileLogicalRouterRoutes(),
+				"agile_deployment_status":     dataSourceAgileDeploymentStatus(),
+				"agile_alarms":                dataSourceAgileAlarms(),
+				"agile_tenants":               dataSourceAgileTenants(),
+				"agile_fabrics":               dataSourceAgileFabrics(),
+				"agile_logical_networks":      dataSourceAgileLogicalNetworks(),
+				"agile_logical_routers":       dataSourceAgileLogicalRouters(),
+				"agile_logical_switches":      dataSourceAgileLogicalSwitches(),
+				"agile_logical_ports":         dataSourceAgileLogicalPorts(),
+				"agile_end_ports":             dataSourceAgileEndPorts(),
+				"agile_external_gateways":     dataSourceAgileExternalGateways(),
+				"agile_dhcp_groups":           dataSourceAgileDhcpGroups(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"agile_tenant":          resourceAgileTenant(),
@@ -77,9 +109,20 @@ func New(version string) func() *schema.Provider {
 				"agile_logical_router":  resourceAgileLogicalRouter(),
 				"agile_logical_switch":  resourceAgileLogicalSwitch(),
 				"agile_end_port":        resourceAgileEndPort(),
+				"agile_qos_policy":      resourceAgileQosPolicy(),
+				"agile_acl":             resourceAgileAcl(),
+				"agile_multicast":       resourceAgileMulticast(),
+				"agile_l2_bridge":       resourceAgileL2Bridge(),
+				"agile_dhcp_relay":      resourceAgileDhcpRelay(),
+				"agile_vpc_connection":  resourceAgileVpcConnection(),
+				"agile_device_group":    resourceAgileDeviceGroup(),
 			},
 		}
 
+		for _, r := range p.ResourcesMap {
+			invalidateCacheOnWrite(r)
+		}
+
 		p.ConfigureContextFunc = configure(version, p)
 
 		return p
@@ -91,6 +134,14 @@ type Config struct {
 	Password   string
 	URL        string
 	IsInsecure bool
+	CacheTTL   time.Duration
+}
+
+// providerMeta is the meta passed by the provider to resources and data sources.
+type providerMeta struct {
+	client     *client.Client
+	cache      *cache
+	controller *models.Controller
 }
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -101,16 +152,52 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			Password:   d.Get("password").(string),
 			URL:        d.Get("api_url").(string),
 			IsInsecure: d.Get("allow_insecure").(bool),
+			CacheTTL:   time.Duration(d.Get("cache_ttl").(int)) * time.Second,
 		}
 
 		if err := config.Valid(); err != nil {
 			return nil, diag.FromErr(err)
 		}
 
-		return config.getClient(), nil
+		agileClient := config.getClient()
+
+		// The controller release decides which features resources can use. Older releases do
+		// not expose it, in which case every feature is left to the controller to validate.
+		controller, err := agileClient.GetController()
+		if err != nil {
+			log.Printf("[WARN] Unable to probe the Agile controller release: %s", err)
+			controller = nil
+		}
+
+		return &providerMeta{
+			client:     agileClient,
+			cache:      newCache(config.CacheTTL),
+			controller: controller,
+		}, nil
 	}
 }
 
+// invalidateCacheOnWrite wraps the create, update and delete functions of r to clear the
+// provider cache before and after they write to the controller, so that neither the read
+// they end with nor later lookups see stale responses.
+func invalidateCacheOnWrite(r *schema.Resource) {
+	wrap := func(f schema.CreateContextFunc) schema.CreateContextFunc {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			agileMeta := meta.(*providerMeta)
+			agileMeta.cache.invalidate()
+			defer agileMeta.cache.invalidate()
+			return f(ctx, d, meta)
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.UpdateContext = schema.UpdateContextFunc(wrap(schema.CreateContextFunc(r.UpdateContext)))
+	r.DeleteContext = schema.DeleteContextFunc(wrap(schema.CreateContextFunc(r.DeleteContext)))
+}
+
 func (c Config) Valid() error {
 
 	if c.Username == "" {
@@ -128,6 +215,6 @@ func (c Config) Valid() error {
 	return nil
 }
 
-func (c Config) getClient() interface{} {
+func (c Config) getClient() *client.Client {
 	return client.GetClient(c.URL, c.Username, c.Password, client.Insecure(c.IsInsecure))
 }
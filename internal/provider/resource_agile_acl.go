@@ -0,0 +1,435 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+func resourceAgileAcl() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages ACLs. ACLs consume the multicast ACL quota (`acl_num` and `acl_rule_num`) of the tenant they belong to.",
+		CreateContext: resourceAgileAclCreate,
+		ReadContext:   resourceAgileAclRead,
+		UpdateContext: resourceAgileAclUpdate,
+		DeleteContext: resourceAgileAclDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgileAclImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "ACL ID.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "ACL name.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.All(
+						validation.StringLenBetween(1, 64),
+						validation.StringDoesNotContainAny(" "),
+					),
+				),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "ACL description.",
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Description:  "Tenant to which the ACL belongs. The tenant must have a multicast quota.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "ACL rules. Rules are matched in the order they are declared.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Description:  "Rule action, which can be permit or deny.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"permit", "deny"}, false),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Description:  "Protocol matched by the rule, which can be any, tcp, udp, icmp or igmp.",
+							Optional:     true,
+							Default:      "any",
+							ValidateFunc: validation.StringInSlice([]string{"any", "tcp", "udp", "icmp", "igmp"}, false),
+						},
+						"source_ip": {
+							Type:         schema.TypeString,
+							Description:  "Source IP address range in CIDR notation. If this parameter is left empty, any source is matched.",
+							Optional:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"destination_ip": {
+							Type:         schema.TypeString,
+							Description:  "Destination IP address range in CIDR notation. If this parameter is left empty, any destination is matched.",
+							Optional:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"source_port_begin": {
+							Type:         schema.TypeInt,
+							Description:  "Start of the source port range. Only valid when the protocol is tcp or udp.",
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"source_port_end": {
+							Type:         schema.TypeInt,
+							Description:  "End of the source port range. Only valid when the protocol is tcp or udp.",
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"destination_port_begin": {
+							Type:         schema.TypeInt,
+							Description:  "Start of the destination port range. Only valid when the protocol is tcp or udp.",
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"destination_port_end": {
+							Type:         schema.TypeInt,
+							Description:  "End of the destination port range. Only valid when the protocol is tcp or udp.",
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"bind_interface": {
+				Type:        schema.TypeSet,
+				Description: "Logical router interfaces the ACL is applied to.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logic_router_id": {
+							Type:         schema.TypeString,
+							Description:  "Logical router to which the interface belongs.",
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"interface_name": {
+							Type:        schema.TypeString,
+							Description: "Logical router interface name.",
+							Required:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringLenBetween(1, 255),
+							),
+						},
+						"direction": {
+							Type:         schema.TypeString,
+							Description:  "Direction of the traffic filtered by the ACL, which can be inbound or outbound.",
+							Optional:     true,
+							Default:      "inbound",
+							ValidateFunc: validation.StringInSlice([]string{"inbound", "outbound"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAgileAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] ACL: Beginning Creation")
+
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
+
+	id, _ := uuid.NewV4()
+
+	name := d.Get("name").(string)
+
+	acl, err := NewAclAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if err := validateAclQuota(agileMeta, *acl.TenantId, "", len(acl.Rules)); err != nil {
+		return err
+	}
+
+	if err := agileClient.CreateAcl(agile.String(id.String()), agile.String(name), acl); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceAgileAclRead(ctx, d, meta)
+}
+
+func resourceAgileAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	acl, err := getAcl(agileMeta, id)
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	if _, err := setAclAttributes(acl, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+func resourceAgileAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: ACL: Beginning Update", d.Id())
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
+
+	name := d.Get("name").(string)
+
+	aclAttr, err := NewAclAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("rule") {
+		if err := validateAclQuota(agileMeta, *aclAttr.TenantId, d.Id(), len(aclAttr.Rules)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := agileClient.UpdateAcl(agile.String(d.Id()), agile.String(name), aclAttr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAgileAclRead(ctx, d, meta)
+}
+
+func resourceAgileAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	if err := agileClient.DeleteAcl(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+
+	d.SetId("")
+
+	return diag.FromErr(nil)
+}
+
+func resourceAgileAclImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	acl, err := getAcl(agileMeta, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schemaFilled, err := setAclAttributes(acl, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+
+	return []*schema.ResourceData{schemaFilled}, nil
+}
+
+// validateAclQuota checks that the tenant multicast quota leaves room for one more ACL holding ruleNum rules.
+// The ACL identified by aclId, if any, is not counted as it is the one being updated.
+func validateAclQuota(agileMeta *providerMeta, tenantId string, aclId string, ruleNum int) diag.Diagnostics {
+	tenant, err := getTenant(agileMeta, tenantId)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tenant.MulticastQuota == nil || tenant.MulticastQuota.AclNum == nil || tenant.MulticastQuota.AclRuleNum == nil {
+		return diag.Errorf("Tenant %s has no multicast quota, acl_num and acl_rule_num must be set to create ACLs.", tenantId)
+	}
+
+	acls, err := listAllAcls(agileMeta, agile.AclRequestOpts{TenantId: tenantId})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	aclNum, aclRuleNum := 1, ruleNum
+	for _, acl := range acls {
+		if acl.TenantId == nil || *acl.TenantId != tenantId || *acl.Id == aclId {
+			continue
+		}
+		aclNum++
+		aclRuleNum += len(acl.Rules)
+	}
+
+	if aclNum > int(*tenant.MulticastQuota.AclNum) {
+		return diag.Errorf("Tenant %s ACL quota exceeded: %d ACLs requested, quota is %d.", tenantId, aclNum, *tenant.MulticastQuota.AclNum)
+	}
+
+	if aclRuleNum > int(*tenant.MulticastQuota.AclRuleNum) {
+		return diag.Errorf("Tenant %s ACL rule quota exceeded: %d ACL rules requested, quota is %d.", tenantId, aclRuleNum, *tenant.MulticastQuota.AclRuleNum)
+	}
+
+	return nil
+}
+
+func NewAclAttributes(d *schema.ResourceData) (*models.AclAttributes, diag.Diagnostics) {
+	aclAttr := models.AclAttributes{
+		TenantId: agile.String(d.Get("tenant_id").(string)),
+	}
+
+	if _, ok := d.GetOk("description"); ok {
+		aclAttr.Description = agile.String(d.Get("description").(string))
+	}
+
+	aclAttr.Rules = make([]*models.AclRule, 0)
+	for i, item := range d.Get("rule").([]interface{}) {
+		rule := item.(map[string]interface{})
+		ruleItem := models.AclRule{
+			Priority: agile.Int32(int32(i + 1)),
+			Action:   agile.String(rule["action"].(string)),
+			Protocol: agile.String(rule["protocol"].(string)),
+		}
+
+		if rule["source_ip"].(string) != "" {
+			ruleItem.SourceIp = agile.String(rule["source_ip"].(string))
+		}
+
+		if rule["destination_ip"].(string) != "" {
+			ruleItem.DestinationIp = agile.String(rule["destination_ip"].(string))
+		}
+
+		sourcePortBegin, sourcePortEnd, err := expandAclRulePortRange(rule, "source", *ruleItem.Priority)
+		if err != nil {
+			return nil, err
+		}
+		ruleItem.SourcePortBegin, ruleItem.SourcePortEnd = sourcePortBegin, sourcePortEnd
+
+		destinationPortBegin, destinationPortEnd, err := expandAclRulePortRange(rule, "destination", *ruleItem.Priority)
+		if err != nil {
+			return nil, err
+		}
+		ruleItem.DestinationPortBegin, ruleItem.DestinationPortEnd = destinationPortBegin, destinationPortEnd
+
+		aclAttr.Rules = append(aclAttr.Rules, &ruleItem)
+	}
+
+	if val, ok := d.GetOk("bind_interface"); ok {
+		aclAttr.BindInterfaces = make([]*models.AclBindInterface, 0)
+		for _, item := range val.(*schema.Set).List() {
+			bindInterface := item.(map[string]interface{})
+			aclAttr.BindInterfaces = append(aclAttr.BindInterfaces, &models.AclBindInterface{
+				LogicRouterId: agile.String(bindInterface["logic_router_id"].(string)),
+				InterfaceName: agile.String(bindInterface["interface_name"].(string)),
+				Direction:     agile.String(bindInterface["direction"].(string)),
+			})
+		}
+	}
+
+	return &aclAttr, nil
+}
+
+// expandAclRulePortRange returns the source or destination port range of a rule, leaving it unset
+// when neither end of the range is configured. Rules are numbered by their priority.
+func expandAclRulePortRange(rule map[string]interface{}, direction string, priority int32) (*int32, *int32, diag.Diagnostics) {
+	begin := rule[direction+"_port_begin"].(int)
+	end := rule[direction+"_port_end"].(int)
+
+	if begin == 0 && end == 0 {
+		return nil, nil, nil
+	}
+
+	if protocol := rule["protocol"].(string); protocol != "tcp" && protocol != "udp" {
+		return nil, nil, diag.Errorf("rule %d: port ranges can only be set when the protocol is tcp or udp.", priority)
+	}
+
+	if begin == 0 || end == 0 {
+		return nil, nil, diag.Errorf("rule %d: %s_port_begin and %s_port_end must be set together.", priority, direction, direction)
+	}
+
+	if end < begin {
+		return nil, nil, diag.Errorf("rule %d: %s_port_end must be greater than or equal to %s_port_begin.", priority, direction, direction)
+	}
+
+	return agile.Int32(int32(begin)), agile.Int32(int32(end)), nil
+}
+
+func setAclAttributes(acl *models.Acl, d *schema.ResourceData) (*schema.ResourceData, error) {
+	if err := d.Set("name", acl.Name); err != nil {
+		return nil, err
+	}
+	if err := d.Set("description", acl.Description); err != nil {
+		return nil, err
+	}
+	if err := d.Set("tenant_id", acl.TenantId); err != nil {
+		return nil, err
+	}
+
+	// Rules are numbered by their position on create, read them back in that order.
+	aclRules := append([]*models.AclRule(nil), acl.Rules...)
+	sort.SliceStable(aclRules, func(i, j int) bool {
+		if aclRules[i].Priority == nil || aclRules[j].Priority == nil {
+			return aclRules[j].Priority == nil && aclRules[i].Priority != nil
+		}
+		return *aclRules[i].Priority < *aclRules[j].Priority
+	})
+
+	var rules []interface{}
+	for _, rule := range aclRules {
+		rules = append(rules, map[string]interface{}{
+			"action":                 rule.Action,
+			"protocol":               rule.Protocol,
+			"source_ip":              rule.SourceIp,
+			"destination_ip":         rule.DestinationIp,
+			"source_port_begin":      rule.SourcePortBegin,
+			"source_port_end":        rule.SourcePortEnd,
+			"destination_port_begin": rule.DestinationPortBegin,
+			"destination_port_end":   rule.DestinationPortEnd,
+		})
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return nil, err
+	}
+
+	var bindInterfaces []interface{}
+	for _, bindInterface := range acl.BindInterfaces {
+		bindInterfaces = append(bindInterfaces, map[string]interface{}{
+			"logic_router_id": bindInterface.LogicRouterId,
+			"interface_name":  bindInterface.InterfaceName,
+			"direction":       bindInterface.Direction,
+		})
+	}
+	if err := d.Set("bind_interface", bindInterfaces); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"fmt"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccAgileAcl_Complete(t *testing.T) {
+	name := "tf_acc_tests_acl"
+
+	aclAttr := models.AclAttributes{
+		Description: agile.String("ACL created via Terraform Tests"),
+		TenantId:    agile.String("cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"),
+		Rules: []*models.AclRule{
+			{
+				Action:               agile.String("permit"),
+				Protocol:             agile.String("tcp"),
+				SourceIp:             agile.String("10.0.0.0/24"),
+				DestinationIp:        agile.String("10.0.1.0/24"),
+				DestinationPortBegin: agile.Int32(443),
+				DestinationPortEnd:   agile.Int32(443),
+			},
+			{
+				Action:   agile.String("deny"),
+				Protocol: agile.String("any"),
+			},
+		},
+	}
+
+	resourceName := "agile_acl.this"
+	var acl models.Acl
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcAclConfig_Complete(name, &aclAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileAclExists(resourceName, &acl),
+					testAccCheckAgileAclAttributes(name, &acl, &aclAttr),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", *aclAttr.Description),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", *aclAttr.TenantId),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", fmt.Sprint(len(aclAttr.Rules))),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.action", *aclAttr.Rules[0].Action),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.protocol", *aclAttr.Rules[0].Protocol),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.source_ip", *aclAttr.Rules[0].SourceIp),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination_ip", *aclAttr.Rules[0].DestinationIp),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.destination_port_begin", fmt.Sprint(*aclAttr.Rules[0].DestinationPortBegin)),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.source_port_begin", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.source_port_end", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.action", *aclAttr.Rules[1].Action),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func TestAccAgileAcl_QuotaExceeded(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAcAclConfig_QuotaExceeded,
+				ExpectError: regexp.MustCompile("ACL rule quota exceeded"),
+			},
+		},
+	})
+}
+
+func TestAccAgileAcl_PortRangeWithoutEnd(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAcAclConfig_PortRangeWithoutEnd,
+				ExpectError: regexp.MustCompile("rule 2: destination_port_begin and destination_port_end must be set together"),
+			},
+		},
+	})
+}
+
+func testAccCheckAcAclConfig_Complete(name string, acl *models.AclAttributes) string {
+	return fmt.Sprintf(`
+	resource "agile_acl" "this" {
+	  name        = "%s"
+      description = "%s"
+	  tenant_id   = "%s"
+      rule {
+		action                 = "%s"
+		protocol               = "%s"
+		source_ip              = "%s"
+		destination_ip         = "%s"
+		destination_port_begin = %d
+		destination_port_end   = %d
+      }
+      rule {
+		action   = "%s"
+		protocol = "%s"
+      }
+	}
+	`, name, *acl.Description, *acl.TenantId,
+		*acl.Rules[0].Action, *acl.Rules[0].Protocol, *acl.Rules[0].SourceIp, *acl.Rules[0].DestinationIp,
+		*acl.Rules[0].DestinationPortBegin, *acl.Rules[0].DestinationPortEnd,
+		*acl.Rules[1].Action, *acl.Rules[1].Protocol)
+}
+
+const testAccCheckAcAclConfig_QuotaExceeded = `
+resource "agile_tenant" "this" {
+	name  = "tf_acc_tests_acl_tenant"
+	quota {
+		logic_vas_num = "1"
+		logic_router_num = "1"
+		logic_switch_num = "1"
+	}
+	multicast_quota {
+		acl_num      = 1
+		acl_rule_num = 1
+	}
+}
+
+resource "agile_acl" "this" {
+	name      = "tf_acc_tests_acl"
+	tenant_id = agile_tenant.this.id
+	rule {
+		action = "permit"
+	}
+	rule {
+		action = "deny"
+	}
+}
+`
+
+const testAccCheckAcAclConfig_PortRangeWithoutEnd = `
+resource "agile_acl" "this" {
+	name      = "tf_acc_tests_acl"
+	tenant_id = "cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"
+	rule {
+		action = "permit"
+	}
+	rule {
+		action                 = "permit"
+		protocol               = "tcp"
+		destination_port_begin = 443
+	}
+}
+`
+
+func testAccCheckAgileAclExists(name string, acl *models.Acl) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("acl %s not found", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no acl id was set")
+		}
+
+		agileClient := testAccProvider.Meta().(*providerMeta).client
+
+		aclFound, err := agileClient.GetAcl(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if *aclFound.Id != rs.Primary.ID {
+			return fmt.Errorf("acl %s not found", rs.Primary.ID)
+		}
+
+		*acl = *aclFound
+		return nil
+	}
+}
+
+func testAccCheckAgileAclAttributes(name string, acl *models.Acl, attributes *models.AclAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if name != *acl.Name {
+			return fmt.Errorf("bad acl Name %s", *acl.Name)
+		}
+
+		if attributes.Description != nil && *acl.Description != *attributes.Description {
+			return fmt.Errorf("bad acl description %s", *acl.Description)
+		}
+
+		if attributes.TenantId != nil && *acl.TenantId != *attributes.TenantId {
+			return fmt.Errorf("bad acl tenant id %s", *acl.TenantId)
+		}
+
+		if len(acl.Rules) != len(attributes.Rules) {
+			return fmt.Errorf("bad acl rules size %d", len(acl.Rules))
+		}
+
+		for i, rule := range attributes.Rules {
+			if *acl.Rules[i].Action != *rule.Action {
+				return fmt.Errorf("bad acl rule %d action %s", i, *acl.Rules[i].Action)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgileAclDestroy(s *terraform.State) error {
+	agileClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+
+		if rs.Type == "agile_acl" {
+			acl, err := agileClient.GetAcl(rs.Primary.ID)
+
+			if acl != nil {
+				return fmt.Errorf("acl %s still exists", *acl.Name)
+			}
+
+			if err == nil {
+				return fmt.Errorf("acl %s still exists", rs.Primary.ID)
+			}
+
+		} else {
+			continue
+		}
+	}
+
+	return nil
+}
+
+func TestSetAclAttributesSortsRulesByPriority(t *testing.T) {
+	acl := &models.Acl{
+		Name:     agile.String("tf_acc_tests_acl"),
+		TenantId: agile.String("cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"),
+		Rules: []*models.AclRule{
+			{Priority: agile.Int32(2), Action: agile.String("deny"), Protocol: agile.String("any")},
+			{Priority: agile.Int32(1), Action: agile.String("permit"), Protocol: agile.String("tcp")},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAgileAcl().Schema, map[string]interface{}{})
+	if _, err := setAclAttributes(acl, d); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if got := d.Get("rule.0.action").(string); got != "permit" {
+		t.Errorf("rule.0.action = %q, want permit", got)
+	}
+
+	if got := d.Get("rule.1.action").(string); got != "deny" {
+		t.Errorf("rule.1.action = %q, want deny", got)
+	}
+
+	if *acl.Rules[0].Priority != 2 {
+		t.Errorf("the controller rules were reordered in place")
+	}
+}
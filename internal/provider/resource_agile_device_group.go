@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/nu7hatch/gouuid"
+	"terraform-provider-agile/tools"
+)
+
+func resourceAgileDeviceGroup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages Device Groups. A device group gathers the fabric devices playing the same role, optionally paired with M-LAG.",
+		CreateContext: resourceAgileDeviceGroupCreate,
+		ReadContext:   resourceAgileDeviceGroupRead,
+		UpdateContext: resourceAgileDeviceGroupUpdate,
+		DeleteContext: resourceAgileDeviceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgileDeviceGroupImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Device group ID.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Device group name.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringDoesNotContainAny(" "),
+					),
+				),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Device group description.",
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"fabric_id": {
+				Type:         schema.TypeString,
+				Description:  "Fabric to which the device group belongs.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Description:  "Role of the devices in the fabric, which can be border_leaf, server_leaf, service_leaf or spine.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"border_leaf", "server_leaf", "service_leaf", "spine"}, false),
+			},
+			"device": {
+				Type:        schema.TypeSet,
+				Description: "Member devices of the group.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:         schema.TypeString,
+							Description:  "Device ID.",
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"device_ip": {
+							Type:        schema.TypeString,
+							Description: "Device management IP.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"mlag": {
+				Type:        schema.TypeList,
+				Description: "M-LAG pairing of the member devices. M-LAG requires exactly two member devices.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"peer_link_port_ids": {
+							Type:        schema.TypeSet,
+							Description: "Ports forming the peer-link between the two member devices.",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+						"heartbeat_ip": {
+							Type:         schema.TypeString,
+							Description:  "IPv4 address used by the dual-active detection heartbeat.",
+							Optional:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"dfs_group_id": {
+							Type:        schema.TypeInt,
+							Description: "DFS group ID of the M-LAG pair.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAgileDeviceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Device Group: Beginning Creation")
+
+	agileClient := meta.(*providerMeta).client
+
+	id, _ := uuid.NewV4()
+
+	name := d.Get("name").(string)
+
+	deviceGroup, err := NewDeviceGroupAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if err := agileClient.CreateDeviceGroup(agile.String(id.String()), agile.String(name), deviceGroup); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceAgileDeviceGroupRead(ctx, d, meta)
+}
+
+func resourceAgileDeviceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	deviceGroup, err := getDeviceGroup(agileMeta, id)
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	if _, err := setDeviceGroupAttributes(deviceGroup, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+func resourceAgileDeviceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Device Group: Beginning Update", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	name := d.Get("name").(string)
+
+	deviceGroupAttr, err := NewDeviceGroupAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := agileClient.UpdateDeviceGroup(agile.String(d.Id()), agile.String(name), deviceGroupAttr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAgileDeviceGroupRead(ctx, d, meta)
+}
+
+func resourceAgileDeviceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	if err := agileClient.DeleteDeviceGroup(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+
+	d.SetId("")
+
+	return diag.FromErr(nil)
+}
+
+func resourceAgileDeviceGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	deviceGroup, err := getDeviceGroup(agileMeta, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schemaFilled, err := setDeviceGroupAttributes(deviceGroup, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+
+	return []*schema.ResourceData{schemaFilled}, nil
+}
+
+func NewDeviceGroupAttributes(d *schema.ResourceData) (*models.DeviceGroupAttributes, diag.Diagnostics) {
+	deviceGroupAttr := models.DeviceGroupAttributes{
+		FabricId: agile.String(d.Get("fabric_id").(string)),
+		Role:     agile.String(d.Get("role").(string)),
+	}
+
+	if _, ok := d.GetOk("description"); ok {
+		deviceGroupAttr.Description = agile.String(d.Get("description").(string))
+	}
+
+	deviceGroupAttr.Devices = make([]*models.DeviceGroupDevice, 0)
+	for _, item := range d.Get("device").(*schema.Set).List() {
+		device := item.(map[string]interface{})
+		deviceGroupAttr.Devices = append(deviceGroupAttr.Devices, &models.DeviceGroupDevice{
+			DeviceId: agile.String(device["device_id"].(string)),
+		})
+	}
+
+	if val, ok := d.GetOk("mlag"); ok {
+		if len(deviceGroupAttr.Devices) != 2 {
+			return nil, diag.Errorf("M-LAG requires exactly two member devices, got %d.", len(deviceGroupAttr.Devices))
+		}
+
+		mlag := val.([]interface{})[0].(map[string]interface{})
+		deviceGroupAttr.Mlag = &models.DeviceGroupMlag{
+			PeerLinkPortIds: tools.ExtractSliceOfStrings(mlag["peer_link_port_ids"].(*schema.Set).List()),
+		}
+
+		if mlag["heartbeat_ip"].(string) != "" {
+			deviceGroupAttr.Mlag.HeartbeatIp = agile.String(mlag["heartbeat_ip"].(string))
+		}
+	}
+
+	return &deviceGroupAttr, nil
+}
+
+func setDeviceGroupAttributes(deviceGroup *models.DeviceGroup, d *schema.ResourceData) (*schema.ResourceData, error) {
+	if err := d.Set("name", deviceGroup.Name); err != nil {
+		return nil, err
+	}
+	if err := d.Set("description", deviceGroup.Description); err != nil {
+		return nil, err
+	}
+	if err := d.Set("fabric_id", deviceGroup.FabricId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("role", deviceGroup.Role); err != nil {
+		return nil, err
+	}
+
+	var devices []interface{}
+	for _, device := range deviceGroup.Devices {
+		devices = append(devices, map[string]interface{}{
+			"device_id": device.DeviceId,
+			"device_ip": device.DeviceIp,
+		})
+	}
+	if err := d.Set("device", devices); err != nil {
+		return nil, err
+	}
+
+	if deviceGroup.Mlag != nil {
+		err := d.Set("mlag", []interface{}{
+			map[string]interface{}{
+				"peer_link_port_ids": tools.CreateSliceOfStrings(deviceGroup.Mlag.PeerLinkPortIds),
+				"heartbeat_ip":       deviceGroup.Mlag.HeartbeatIp,
+				"dfs_group_id":       deviceGroup.Mlag.DfsGroupId,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else if err := d.Set("mlag", nil); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"fmt"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccAgileDeviceGroup_Complete(t *testing.T) {
+	name := "tf_acc_tests_deviceGroup"
+
+	deviceGroupAttr := models.DeviceGroupAttributes{
+		Description: agile.String("Device Group created via Terraform Tests"),
+		FabricId:    agile.String("f1429224-1860-4bdb-8cc8-98ccc0f5563a"),
+		Role:        agile.String("server_leaf"),
+		Devices: []*models.DeviceGroupDevice{
+			{DeviceId: agile.String("9e3a5bee-3d95-3bf7-90f5-09bd2177324b")},
+			{DeviceId: agile.String("b4f6d9ed-0f1d-3f7a-82f1-a4a7ea4f84d4")},
+		},
+		Mlag: &models.DeviceGroupMlag{
+			PeerLinkPortIds: []*string{agile.String("589c87dd-7222-3c09-87b7-d09a236af285")},
+			HeartbeatIp:     agile.String("192.168.100.1"),
+		},
+	}
+
+	deviceGroupUpdate := deviceGroupAttr
+	deviceGroupUpdate.Description = agile.String("Device Group Updated via Terraform Agile Provider Acceptance tests")
+
+	resourceName := "agile_device_group.this"
+	var deviceGroup models.DeviceGroup
+	var deviceGroupUpdated models.DeviceGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileDeviceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcDeviceGroupConfig_Complete(name, &deviceGroupAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileDeviceGroupExists(resourceName, &deviceGroup),
+					testAccCheckAgileDeviceGroupAttributes(name, &deviceGroup, &deviceGroupAttr),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", *deviceGroupAttr.Description),
+					resource.TestCheckResourceAttr(resourceName, "fabric_id", *deviceGroupAttr.FabricId),
+					resource.TestCheckResourceAttr(resourceName, "role", *deviceGroupAttr.Role),
+					resource.TestCheckResourceAttr(resourceName, "device.#", fmt.Sprint(len(deviceGroupAttr.Devices))),
+					resource.TestCheckResourceAttr(resourceName, "mlag.0.heartbeat_ip", *deviceGroupAttr.Mlag.HeartbeatIp),
+					resource.TestCheckResourceAttrSet(resourceName, "mlag.0.dfs_group_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			{
+				Config: testAccCheckAcDeviceGroupConfig_Complete(name, &deviceGroupUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileDeviceGroupExists(resourceName, &deviceGroupUpdated),
+					testAccCheckAgileDeviceGroupAttributes(name, &deviceGroupUpdated, &deviceGroupUpdate),
+					resource.TestCheckResourceAttr(resourceName, "description", *deviceGroupUpdate.Description),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgileDeviceGroup_MlagRequiresTwoDevices(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileDeviceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+	resource "agile_device_group" "this" {
+	  name      = "tf_acc_tests_deviceGroup"
+	  fabric_id = "f1429224-1860-4bdb-8cc8-98ccc0f5563a"
+	  role      = "server_leaf"
+	  device {
+		device_id = "9e3a5bee-3d95-3bf7-90f5-09bd2177324b"
+	  }
+	  mlag {
+		peer_link_port_ids = ["589c87dd-7222-3c09-87b7-d09a236af285"]
+	  }
+	}
+	`,
+				ExpectError: regexp.MustCompile("M-LAG requires exactly two member devices"),
+			},
+		},
+	})
+}
+
+func testAccCheckAcDeviceGroupConfig_Complete(name string, deviceGroup *models.DeviceGroupAttributes) string {
+	return fmt.Sprintf(`
+	resource "agile_device_group" "this" {
+	  name        = "%s"
+      description = "%s"
+	  fabric_id   = "%s"
+      role        = "%s"
+      device {
+		device_id = "%s"
+      }
+      device {
+		device_id = "%s"
+      }
+      mlag {
+		peer_link_port_ids = %s
+		heartbeat_ip       = "%s"
+      }
+	}
+	`, name, *deviceGroup.Description, *deviceGroup.FabricId, *deviceGroup.Role,
+		*deviceGroup.Devices[0].DeviceId, *deviceGroup.Devices[1].DeviceId,
+		testAccQuotedList(deviceGroup.Mlag.PeerLinkPortIds), *deviceGroup.Mlag.HeartbeatIp)
+}
+
+func testAccCheckAgileDeviceGroupExists(name string, deviceGroup *models.DeviceGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("device group %s not found", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no device group id was set")
+		}
+
+		agileClient := testAccProvider.Meta().(*providerMeta).client
+
+		deviceGroupFound, err := agileClient.GetDeviceGroup(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if *deviceGroupFound.Id != rs.Primary.ID {
+			return fmt.Errorf("device group %s not found", rs.Primary.ID)
+		}
+
+		*deviceGroup = *deviceGroupFound
+		return nil
+	}
+}
+
+func testAccCheckAgileDeviceGroupAttributes(name string, deviceGroup *models.DeviceGroup, attributes *models.DeviceGroupAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if name != *deviceGroup.Name {
+			return fmt.Errorf("bad device group Name %s", *deviceGroup.Name)
+		}
+
+		if attributes.Description != nil && *deviceGroup.Description != *attributes.Description {
+			return fmt.Errorf("bad device group description %s", *deviceGroup.Description)
+		}
+
+		if *deviceGroup.FabricId != *attributes.FabricId {
+			return fmt.Errorf("bad device group fabric id %s", *deviceGroup.FabricId)
+		}
+
+		if *deviceGroup.Role != *attributes.Role {
+			return fmt.Errorf("bad device group role %s", *deviceGroup.Role)
+		}
+
+		if len(deviceGroup.Devices) != len(attributes.Devices) {
+			return fmt.Errorf("bad device group devices size %d", len(deviceGroup.Devices))
+		}
+
+		if attributes.Mlag != nil && deviceGroup.Mlag == nil {
+			return fmt.Errorf("bad device group mlag, expected M-LAG to be enabled")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgileDeviceGroupDestroy(s *terraform.State) error {
+	agileClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+
+		if rs.Type == "agile_device_group" {
+			deviceGroup, err := agileClient.GetDeviceGroup(rs.Primary.ID)
+
+			if deviceGroup != nil {
+				return fmt.Errorf("device group %s still exists", *deviceGroup.Name)
+			}
+
+			if err == nil {
+				return fmt.Errorf("device group %s still exists", rs.Primary.ID)
+			}
+
+		} else {
+			continue
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/nu7hatch/gouuid"
+	"terraform-provider-agile/tools"
+)
+
+func resourceAgileDhcpRelay() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages DHCP relay on a logical switch or a logical router interface.",
+		CreateContext: resourceAgileDhcpRelayCreate,
+		ReadContext:   resourceAgileDhcpRelayRead,
+		UpdateContext: resourceAgileDhcpRelayUpdate,
+		DeleteContext: resourceAgileDhcpRelayDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgileDhcpRelayImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "DHCP relay ID.",
+				Computed:    true,
+			},
+			"logic_switch_id": {
+				Type:         schema.TypeString,
+				Description:  "Logical switch on which the DHCP relay is enabled. Conflicts with `logic_router_id`.",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"logic_switch_id", "logic_router_id"},
+				ValidateFunc: validation.IsUUID,
+			},
+			"logic_router_id": {
+				Type:         schema.TypeString,
+				Description:  "Logical router on which the DHCP relay is enabled. Requires `interface_name`.",
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"interface_name"},
+				ValidateFunc: validation.IsUUID,
+			},
+			"interface_name": {
+				Type:         schema.TypeString,
+				Description:  "Logical router interface on which the DHCP relay is enabled.",
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"logic_router_id"},
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(1, 255),
+				),
+			},
+			"dhcp_group_id": {
+				Type:         schema.TypeString,
+				Description:  "DHCP group the requests are relayed to. It can be retrieved with the `agile_dhcp_group` data source.",
+				Optional:     true,
+				AtLeastOneOf: []string{"dhcp_group_id", "server_ips"},
+				ValidateFunc: validation.IsUUID,
+			},
+			"server_ips": {
+				Type:        schema.TypeList,
+				Description: "IPv4 addresses of the DHCP servers the requests are relayed to.",
+				Optional:    true,
+				MaxItems:    8,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"option82": {
+				Type:        schema.TypeList,
+				Description: "Option 82 Settings.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:        schema.TypeBool,
+							Description: "Whether to insert the Option 82 field in the relayed requests.",
+							Optional:    true,
+							Default:     true,
+						},
+						"strategy": {
+							Type:         schema.TypeString,
+							Description:  "Strategy applied to requests already carrying Option 82, which can be keep, replace or drop.",
+							Optional:     true,
+							Default:      "replace",
+							ValidateFunc: validation.StringInSlice([]string{"keep", "replace", "drop"}, false),
+						},
+						"circuit_id": {
+							Type:        schema.TypeString,
+							Description: "Circuit ID sub-option. If this parameter is left empty, the controller generates it.",
+							Optional:    true,
+							Computed:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringLenBetween(0, 64),
+							),
+						},
+						"remote_id": {
+							Type:        schema.TypeString,
+							Description: "Remote ID sub-option. If this parameter is left empty, the controller generates it.",
+							Optional:    true,
+							Computed:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringLenBetween(0, 64),
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAgileDhcpRelayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] DHCP Relay: Beginning Creation")
+
+	agileClient := meta.(*providerMeta).client
+
+	id, _ := uuid.NewV4()
+
+	dhcpRelay, err := NewDhcpRelayAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if err := agileClient.CreateDHCPRelay(agile.String(id.String()), dhcpRelay); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceAgileDhcpRelayRead(ctx, d, meta)
+}
+
+func resourceAgileDhcpRelayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	dhcpRelay, err := getDHCPRelay(agileMeta, id)
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	if _, err := setDhcpRelayAttributes(dhcpRelay, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+func resourceAgileDhcpRelayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: DHCP Relay: Beginning Update", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	dhcpRelayAttr, err := NewDhcpRelayAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := agileClient.UpdateDHCPRelay(agile.String(d.Id()), dhcpRelayAttr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAgileDhcpRelayRead(ctx, d, meta)
+}
+
+func resourceAgileDhcpRelayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	if err := agileClient.DeleteDHCPRelay(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+
+	d.SetId("")
+
+	return diag.FromErr(nil)
+}
+
+func resourceAgileDhcpRelayImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	dhcpRelay, err := getDHCPRelay(agileMeta, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schemaFilled, err := setDhcpRelayAttributes(dhcpRelay, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+
+	return []*schema.ResourceData{schemaFilled}, nil
+}
+
+func NewDhcpRelayAttributes(d *schema.ResourceData) (*models.DHCPRelayAttributes, diag.Diagnostics) {
+	dhcpRelayAttr := models.DHCPRelayAttributes{}
+
+	if _, ok := d.GetOk("logic_switch_id"); ok {
+		dhcpRelayAttr.LogicSwitchId = agile.String(d.Get("logic_switch_id").(string))
+	}
+
+	if _, ok := d.GetOk("logic_router_id"); ok {
+		dhcpRelayAttr.LogicRouterId = agile.String(d.Get("logic_router_id").(string))
+		dhcpRelayAttr.InterfaceName = agile.String(d.Get("interface_name").(string))
+	}
+
+	if _, ok := d.GetOk("dhcp_group_id"); ok {
+		dhcpRelayAttr.DhcpGroupId = agile.String(d.Get("dhcp_group_id").(string))
+	}
+
+	if val, ok := d.GetOk("server_ips"); ok {
+		dhcpRelayAttr.ServerIps = tools.ExtractSliceOfStrings(val.([]interface{}))
+	}
+
+	if val, ok := d.GetOk("option82"); ok {
+		option82 := val.([]interface{})[0].(map[string]interface{})
+		dhcpRelayAttr.Option82 = &models.DHCPRelayOption82{
+			Enable:   agile.Bool(option82["enable"].(bool)),
+			Strategy: agile.String(option82["strategy"].(string)),
+		}
+
+		if option82["circuit_id"].(string) != "" {
+			dhcpRelayAttr.Option82.CircuitId = agile.String(option82["circuit_id"].(string))
+		}
+
+		if option82["remote_id"].(string) != "" {
+			dhcpRelayAttr.Option82.RemoteId = agile.String(option82["remote_id"].(string))
+		}
+	}
+
+	return &dhcpRelayAttr, nil
+}
+
+func setDhcpRelayAttributes(dhcpRelay *models.DHCPRelay, d *schema.ResourceData) (*schema.ResourceData, error) {
+	if err := d.Set("logic_switch_id", dhcpRelay.LogicSwitchId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("logic_router_id", dhcpRelay.LogicRouterId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("interface_name", dhcpRelay.InterfaceName); err != nil {
+		return nil, err
+	}
+	if err := d.Set("dhcp_group_id", dhcpRelay.DhcpGroupId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("server_ips", tools.CreateSliceOfStrings(dhcpRelay.ServerIps)); err != nil {
+		return nil, err
+	}
+
+	if dhcpRelay.Option82 != nil {
+		err := d.Set("option82", []interface{}{
+			map[string]interface{}{
+				"enable":     dhcpRelay.Option82.Enable,
+				"strategy":   dhcpRelay.Option82.Strategy,
+				"circuit_id": dhcpRelay.Option82.CircuitId,
+				"remote_id":  dhcpRelay.Option82.RemoteId,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"fmt"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccAgileDhcpRelay_Complete(t *testing.T) {
+	dhcpRelayAttr := models.DHCPRelayAttributes{
+		LogicSwitchId: agile.String("6c0a96d3-0789-47e6-9dbc-66ac5ba2e519"),
+		ServerIps:     []*string{agile.String("10.0.0.10"), agile.String("10.0.0.11")},
+		Option82: &models.DHCPRelayOption82{
+			Enable:    agile.Bool(true),
+			Strategy:  agile.String("replace"),
+			CircuitId: agile.String("tf_acc_tests"),
+			RemoteId:  agile.String("tf_acc_tests"),
+		},
+	}
+
+	dhcpRelayUpdate := dhcpRelayAttr
+	dhcpRelayUpdate.ServerIps = []*string{agile.String("10.0.0.12")}
+
+	resourceName := "agile_dhcp_relay.this"
+	var dhcpRelay models.DHCPRelay
+	var dhcpRelayUpdated models.DHCPRelay
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileDhcpRelayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcDhcpRelayConfig_Complete(&dhcpRelayAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileDhcpRelayExists(resourceName, &dhcpRelay),
+					testAccCheckAgileDhcpRelayAttributes(&dhcpRelay, &dhcpRelayAttr),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "logic_switch_id", *dhcpRelayAttr.LogicSwitchId),
+					resource.TestCheckResourceAttr(resourceName, "server_ips.#", fmt.Sprint(len(dhcpRelayAttr.ServerIps))),
+					resource.TestCheckResourceAttr(resourceName, "server_ips.0", *dhcpRelayAttr.ServerIps[0]),
+					resource.TestCheckResourceAttr(resourceName, "option82.0.enable", fmt.Sprint(*dhcpRelayAttr.Option82.Enable)),
+					resource.TestCheckResourceAttr(resourceName, "option82.0.strategy", *dhcpRelayAttr.Option82.Strategy),
+					resource.TestCheckResourceAttr(resourceName, "option82.0.circuit_id", *dhcpRelayAttr.Option82.CircuitId),
+					resource.TestCheckResourceAttr(resourceName, "option82.0.remote_id", *dhcpRelayAttr.Option82.RemoteId),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			{
+				Config: testAccCheckAcDhcpRelayConfig_Complete(&dhcpRelayUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileDhcpRelayExists(resourceName, &dhcpRelayUpdated),
+					testAccCheckAgileDhcpRelayAttributes(&dhcpRelayUpdated, &dhcpRelayUpdate),
+					resource.TestCheckResourceAttr(resourceName, "server_ips.#", fmt.Sprint(len(dhcpRelayUpdate.ServerIps))),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAcDhcpRelayConfig_Complete(dhcpRelay *models.DHCPRelayAttributes) string {
+	return fmt.Sprintf(`
+	resource "agile_dhcp_relay" "this" {
+	  logic_switch_id = "%s"
+	  server_ips      = %s
+      option82 {
+		enable     = %t
+		strategy   = "%s"
+		circuit_id = "%s"
+		remote_id  = "%s"
+      }
+	}
+	`, *dhcpRelay.LogicSwitchId, testAccQuotedList(dhcpRelay.ServerIps),
+		*dhcpRelay.Option82.Enable, *dhcpRelay.Option82.Strategy, *dhcpRelay.Option82.CircuitId, *dhcpRelay.Option82.RemoteId)
+}
+
+func testAccQuotedList(items []*string) string {
+	list := "["
+	for i, item := range items {
+		if i > 0 {
+			list += ", "
+		}
+		list += fmt.Sprintf("%q", *item)
+	}
+	return list + "]"
+}
+
+func testAccCheckAgileDhcpRelayExists(name string, dhcpRelay *models.DHCPRelay) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("dhcp relay %s not found", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no dhcp relay id was set")
+		}
+
+		agileClient := testAccProvider.Meta().(*providerMeta).client
+
+		dhcpRelayFound, err := agileClient.GetDHCPRelay(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if *dhcpRelayFound.Id != rs.Primary.ID {
+			return fmt.Errorf("dhcp relay %s not found", rs.Primary.ID)
+		}
+
+		*dhcpRelay = *dhcpRelayFound
+		return nil
+	}
+}
+
+func testAccCheckAgileDhcpRelayAttributes(dhcpRelay *models.DHCPRelay, attributes *models.DHCPRelayAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if attributes.LogicSwitchId != nil && *dhcpRelay.LogicSwitchId != *attributes.LogicSwitchId {
+			return fmt.Errorf("bad dhcp relay logical switch id %s", *dhcpRelay.LogicSwitchId)
+		}
+
+		if len(dhcpRelay.ServerIps) != len(attributes.ServerIps) {
+			return fmt.Errorf("bad dhcp relay server ips size %d", len(dhcpRelay.ServerIps))
+		}
+
+		for i, serverIp := range attributes.ServerIps {
+			if *dhcpRelay.ServerIps[i] != *serverIp {
+				return fmt.Errorf("bad dhcp relay server ip %s", *dhcpRelay.ServerIps[i])
+			}
+		}
+
+		if attributes.Option82 != nil && *dhcpRelay.Option82.Enable != *attributes.Option82.Enable {
+			return fmt.Errorf("bad dhcp relay option82 enable %t", *dhcpRelay.Option82.Enable)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgileDhcpRelayDestroy(s *terraform.State) error {
+	agileClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+
+		if rs.Type == "agile_dhcp_relay" {
+			dhcpRelay, err := agileClient.GetDHCPRelay(rs.Primary.ID)
+
+			if dhcpRelay != nil {
+				return fmt.Errorf("dhcp relay %s still exists", rs.Primary.ID)
+			}
+
+			if err == nil {
+				return fmt.Errorf("dhcp relay %s still exists", rs.Primary.ID)
+			}
+
+		} else {
+			continue
+		}
+	}
+
+	return nil
+}
@@ -103,7 +103,7 @@ func resourceAgileEndPort() *schema.Resource {
 func resourceAgileEndPortCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] End Port: Beginning Creation")
 
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	id, _ := uuid.NewV4()
 
@@ -126,9 +126,9 @@ func resourceAgileEndPortCreate(ctx context.Context, d *schema.ResourceData, met
 
 func resourceAgileEndPortRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Read End Port", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 	id := d.Id()
-	endPort, err := agileClient.GetEndPort(id)
+	endPort, err := getEndPort(agileMeta, id)
 
 	if err != nil {
 		d.SetId("")
@@ -145,7 +145,7 @@ func resourceAgileEndPortRead(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceAgileEndPortUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: End Port: Beginning Update", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 
@@ -164,7 +164,7 @@ func resourceAgileEndPortUpdate(ctx context.Context, d *schema.ResourceData, met
 
 func resourceAgileEndPortDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	err := agileClient.DeleteEndPort(d.Id())
 
@@ -216,10 +216,10 @@ func NewEndPortAttributes(d *schema.ResourceData) (*models.EndPortAttributes, di
 
 func resourceAgileEndPortImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	endPort, err := agileClient.GetEndPort(id)
+	endPort, err := getEndPort(agileMeta, id)
 
 	if err != nil {
 		return nil, err
@@ -167,7 +167,7 @@ func testAccCheckAgileEndPortExists(name string, endort *models.EndPort) resourc
 			return fmt.Errorf("no end port id was set")
 		}
 
-		agileClient := testAccProvider.Meta().(*agile.Client)
+		agileClient := testAccProvider.Meta().(*providerMeta).client
 
 		endPortFound, err := agileClient.GetEndPort(rs.Primary.ID)
 		if err != nil {
@@ -184,7 +184,7 @@ func testAccCheckAgileEndPortExists(name string, endort *models.EndPort) resourc
 }
 
 func testAccCheckAgileEndPortDestroy(s *terraform.State) error {
-	agileClient := testAccProvider.Meta().(*agile.Client)
+	agileClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 
@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+func resourceAgileL2Bridge() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages Layer-2 bridges between a logical switch and a VLAN on physical border leaf ports.",
+		CreateContext: resourceAgileL2BridgeCreate,
+		ReadContext:   resourceAgileL2BridgeRead,
+		UpdateContext: resourceAgileL2BridgeUpdate,
+		DeleteContext: resourceAgileL2BridgeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgileL2BridgeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Layer-2 bridge ID.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Layer-2 bridge name.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringDoesNotContainAny(" "),
+					),
+				),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Layer-2 bridge description.",
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"logic_switch_id": {
+				Type:         schema.TypeString,
+				Description:  "Logical switch bridged to the VLAN.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"vlan": {
+				Type:         schema.TypeInt,
+				Description:  "VLAN ID bridged to the logical switch. The VLAN cannot already be bridged on the same devices.",
+				Required:     true,
+				ValidateFunc: validation.IntBetween(2, 4094),
+			},
+			"bd": {
+				Type:        schema.TypeInt,
+				Description: "BD ID of the bridged logical switch.",
+				Computed:    true,
+			},
+			"vni": {
+				Type:        schema.TypeInt,
+				Description: "VNI of the bridged logical switch.",
+				Computed:    true,
+			},
+			"location": {
+				Type:        schema.TypeSet,
+				Description: "Border leaf ports on which the VLAN is bridged.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_group_id": {
+							Type:         schema.TypeString,
+							Description:  "Device group ID of a physical device.",
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"device_id": {
+							Type:         schema.TypeString,
+							Description:  "Specified physical device.",
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"port_id": {
+							Type:         schema.TypeString,
+							Description:  "Specified physical port.",
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"port_name": {
+							Type:        schema.TypeString,
+							Description: "Port name.",
+							Computed:    true,
+						},
+						"device_ip": {
+							Type:        schema.TypeString,
+							Description: "Device management IP address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAgileL2BridgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] L2 Bridge: Beginning Creation")
+
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
+
+	id, _ := uuid.NewV4()
+
+	name := d.Get("name").(string)
+
+	l2Bridge, err := NewL2BridgeAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if err := validateL2BridgeVlan(agileMeta, "", l2Bridge); err != nil {
+		return err
+	}
+
+	if err := agileClient.CreateL2Bridge(agile.String(id.String()), agile.String(name), l2Bridge); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceAgileL2BridgeRead(ctx, d, meta)
+}
+
+func resourceAgileL2BridgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	l2Bridge, err := getL2Bridge(agileMeta, id)
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	if _, err := setL2BridgeAttributes(l2Bridge, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+func resourceAgileL2BridgeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: L2 Bridge: Beginning Update", d.Id())
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
+
+	name := d.Get("name").(string)
+
+	l2BridgeAttr, err := NewL2BridgeAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("vlan", "location") {
+		if err := validateL2BridgeVlan(agileMeta, d.Id(), l2BridgeAttr); err != nil {
+			return err
+		}
+	}
+
+	if _, err := agileClient.UpdateL2Bridge(agile.String(d.Id()), agile.String(name), l2BridgeAttr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAgileL2BridgeRead(ctx, d, meta)
+}
+
+func resourceAgileL2BridgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	if err := agileClient.DeleteL2Bridge(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+
+	d.SetId("")
+
+	return diag.FromErr(nil)
+}
+
+func resourceAgileL2BridgeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	l2Bridge, err := getL2Bridge(agileMeta, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schemaFilled, err := setL2BridgeAttributes(l2Bridge, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+
+	return []*schema.ResourceData{schemaFilled}, nil
+}
+
+// validateL2BridgeVlan fails when the VLAN of l2Bridge is already bridged on one of its devices by another bridge.
+// The bridge identified by l2BridgeId, if any, is ignored as it is the one being updated.
+func validateL2BridgeVlan(agileMeta *providerMeta, l2BridgeId string, l2Bridge *models.L2BridgeAttributes) diag.Diagnostics {
+	l2Bridges, err := listAllL2Bridges(agileMeta, agile.L2BridgeRequestOpts{})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if l2Bridge.Vlan == nil {
+		return nil
+	}
+
+	devices := make(map[string]bool)
+	for _, location := range l2Bridge.Locations {
+		if location != nil && location.DeviceId != nil {
+			devices[*location.DeviceId] = true
+		}
+	}
+
+	for _, existing := range l2Bridges {
+		if (existing.Id != nil && *existing.Id == l2BridgeId) || existing.Vlan == nil || *existing.Vlan != *l2Bridge.Vlan {
+			continue
+		}
+		for _, location := range existing.Locations {
+			if location != nil && location.DeviceId != nil && devices[*location.DeviceId] {
+				return diag.Errorf("VLAN %d is already bridged to logical switch %s on device %s by layer-2 bridge %s.",
+					*l2Bridge.Vlan, stringValue(existing.LogicSwitchId), *location.DeviceId, stringValue(existing.Name, existing.Id))
+			}
+		}
+	}
+
+	return nil
+}
+
+func NewL2BridgeAttributes(d *schema.ResourceData) (*models.L2BridgeAttributes, diag.Diagnostics) {
+	l2BridgeAttr := models.L2BridgeAttributes{
+		LogicSwitchId: agile.String(d.Get("logic_switch_id").(string)),
+		Vlan:          agile.Int32(int32(d.Get("vlan").(int))),
+	}
+
+	if _, ok := d.GetOk("description"); ok {
+		l2BridgeAttr.Description = agile.String(d.Get("description").(string))
+	}
+
+	l2BridgeAttr.Locations = make([]*models.L2BridgeLocation, 0)
+	for _, item := range d.Get("location").(*schema.Set).List() {
+		location := item.(map[string]interface{})
+		locationItem := models.L2BridgeLocation{
+			DeviceId: agile.String(location["device_id"].(string)),
+			PortId:   agile.String(location["port_id"].(string)),
+		}
+
+		if location["device_group_id"].(string) != "" {
+			locationItem.DeviceGroupId = agile.String(location["device_group_id"].(string))
+		}
+
+		l2BridgeAttr.Locations = append(l2BridgeAttr.Locations, &locationItem)
+	}
+
+	return &l2BridgeAttr, nil
+}
+
+func setL2BridgeAttributes(l2Bridge *models.L2Bridge, d *schema.ResourceData) (*schema.ResourceData, error) {
+	if err := d.Set("name", l2Bridge.Name); err != nil {
+		return nil, err
+	}
+	if err := d.Set("description", l2Bridge.Description); err != nil {
+		return nil, err
+	}
+	if err := d.Set("logic_switch_id", l2Bridge.LogicSwitchId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("vlan", l2Bridge.Vlan); err != nil {
+		return nil, err
+	}
+	if err := d.Set("bd", l2Bridge.Bd); err != nil {
+		return nil, err
+	}
+	if err := d.Set("vni", l2Bridge.Vni); err != nil {
+		return nil, err
+	}
+
+	var locations []interface{}
+	for _, location := range l2Bridge.Locations {
+		locations = append(locations, map[string]interface{}{
+			"device_group_id": location.DeviceGroupId,
+			"device_id":       location.DeviceId,
+			"port_id":         location.PortId,
+			"port_name":       location.PortName,
+			"device_ip":       location.DeviceIp,
+		})
+	}
+	if err := d.Set("location", locations); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"fmt"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccAgileL2Bridge_Complete(t *testing.T) {
+	name := "tf_acc_tests_l2Bridge"
+
+	l2BridgeAttr := models.L2BridgeAttributes{
+		Description:   agile.String("L2 Bridge created via Terraform Tests"),
+		LogicSwitchId: agile.String("6c0a96d3-0789-47e6-9dbc-66ac5ba2e519"),
+		Vlan:          agile.Int32(1219),
+		Locations: []*models.L2BridgeLocation{
+			{
+				DeviceGroupId: agile.String("e13784fb-499f-4c30-8f9c-e49e6c98fdbb"),
+				DeviceId:      agile.String("9e3a5bee-3d95-3bf7-90f5-09bd2177324b"),
+				PortId:        agile.String("589c87dd-7222-3c09-87b7-d09a236af285"),
+			},
+		},
+	}
+
+	l2BridgeUpdate := l2BridgeAttr
+	l2BridgeUpdate.Description = agile.String("L2 Bridge Updated via Terraform Agile Provider Acceptance tests")
+	l2BridgeUpdate.Vlan = agile.Int32(1220)
+
+	resourceName := "agile_l2_bridge.this"
+	var l2Bridge models.L2Bridge
+	var l2BridgeUpdated models.L2Bridge
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileL2BridgeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcL2BridgeConfig_Complete(name, &l2BridgeAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileL2BridgeExists(resourceName, &l2Bridge),
+					testAccCheckAgileL2BridgeAttributes(name, &l2Bridge, &l2BridgeAttr),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "bd"),
+					resource.TestCheckResourceAttrSet(resourceName, "vni"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", *l2BridgeAttr.Description),
+					resource.TestCheckResourceAttr(resourceName, "logic_switch_id", *l2BridgeAttr.LogicSwitchId),
+					resource.TestCheckResourceAttr(resourceName, "vlan", fmt.Sprint(*l2BridgeAttr.Vlan)),
+					resource.TestCheckResourceAttr(resourceName, "location.#", fmt.Sprint(len(l2BridgeAttr.Locations))),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			{
+				Config: testAccCheckAcL2BridgeConfig_Complete(name, &l2BridgeUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileL2BridgeExists(resourceName, &l2BridgeUpdated),
+					testAccCheckAgileL2BridgeAttributes(name, &l2BridgeUpdated, &l2BridgeUpdate),
+					resource.TestCheckResourceAttr(resourceName, "description", *l2BridgeUpdate.Description),
+					resource.TestCheckResourceAttr(resourceName, "vlan", fmt.Sprint(*l2BridgeUpdate.Vlan)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgileL2Bridge_VlanAlreadyBridged(t *testing.T) {
+	l2BridgeAttr := models.L2BridgeAttributes{
+		Description:   agile.String("L2 Bridge created via Terraform Tests"),
+		LogicSwitchId: agile.String("6c0a96d3-0789-47e6-9dbc-66ac5ba2e519"),
+		Vlan:          agile.Int32(1219),
+		Locations: []*models.L2BridgeLocation{
+			{
+				DeviceGroupId: agile.String("e13784fb-499f-4c30-8f9c-e49e6c98fdbb"),
+				DeviceId:      agile.String("9e3a5bee-3d95-3bf7-90f5-09bd2177324b"),
+				PortId:        agile.String("589c87dd-7222-3c09-87b7-d09a236af285"),
+			},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileL2BridgeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcL2BridgeConfig_Complete("tf_acc_tests_l2Bridge", &l2BridgeAttr),
+			},
+			{
+				Config: testAccCheckAcL2BridgeConfig_Complete("tf_acc_tests_l2Bridge", &l2BridgeAttr) +
+					`
+	resource "agile_l2_bridge" "duplicate" {
+	  name            = "tf_acc_tests_l2Bridge_duplicate"
+	  logic_switch_id = agile_l2_bridge.this.logic_switch_id
+	  vlan            = agile_l2_bridge.this.vlan
+	  location {
+		device_id = "9e3a5bee-3d95-3bf7-90f5-09bd2177324b"
+		port_id   = "4c142b5e-1858-33b2-a03e-71dcc3b37360"
+	  }
+	}
+	`,
+				ExpectError: regexp.MustCompile("is already bridged"),
+			},
+		},
+	})
+}
+
+func testAccCheckAcL2BridgeConfig_Complete(name string, l2Bridge *models.L2BridgeAttributes) string {
+	return fmt.Sprintf(`
+	resource "agile_l2_bridge" "this" {
+	  name            = "%s"
+      description     = "%s"
+	  logic_switch_id = "%s"
+      vlan            = %d
+      location {
+		device_group_id = "%s"
+		device_id       = "%s"
+		port_id         = "%s"
+      }
+	}
+	`, name, *l2Bridge.Description, *l2Bridge.LogicSwitchId, *l2Bridge.Vlan,
+		*l2Bridge.Locations[0].DeviceGroupId, *l2Bridge.Locations[0].DeviceId, *l2Bridge.Locations[0].PortId)
+}
+
+func testAccCheckAgileL2BridgeExists(name string, l2Bridge *models.L2Bridge) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("l2 bridge %s not found", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no l2 bridge id was set")
+		}
+
+		agileClient := testAccProvider.Meta().(*providerMeta).client
+
+		l2BridgeFound, err := agileClient.GetL2Bridge(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if *l2BridgeFound.Id != rs.Primary.ID {
+			return fmt.Errorf("l2 bridge %s not found", rs.Primary.ID)
+		}
+
+		*l2Bridge = *l2BridgeFound
+		return nil
+	}
+}
+
+func testAccCheckAgileL2BridgeAttributes(name string, l2Bridge *models.L2Bridge, attributes *models.L2BridgeAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if name != *l2Bridge.Name {
+			return fmt.Errorf("bad l2 bridge Name %s", *l2Bridge.Name)
+		}
+
+		if attributes.Description != nil && *l2Bridge.Description != *attributes.Description {
+			return fmt.Errorf("bad l2 bridge description %s", *l2Bridge.Description)
+		}
+
+		if *l2Bridge.LogicSwitchId != *attributes.LogicSwitchId {
+			return fmt.Errorf("bad l2 bridge logical switch id %s", *l2Bridge.LogicSwitchId)
+		}
+
+		if *l2Bridge.Vlan != *attributes.Vlan {
+			return fmt.Errorf("bad l2 bridge vlan %d", *l2Bridge.Vlan)
+		}
+
+		if len(l2Bridge.Locations) != len(attributes.Locations) {
+			return fmt.Errorf("bad l2 bridge locations size %d", len(l2Bridge.Locations))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgileL2BridgeDestroy(s *terraform.State) error {
+	agileClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+
+		if rs.Type == "agile_l2_bridge" {
+			l2Bridge, err := agileClient.GetL2Bridge(rs.Primary.ID)
+
+			if l2Bridge != nil {
+				return fmt.Errorf("l2 bridge %s still exists", *l2Bridge.Name)
+			}
+
+			if err == nil {
+				return fmt.Errorf("l2 bridge %s still exists", rs.Primary.ID)
+			}
+
+		} else {
+			continue
+		}
+	}
+
+	return nil
+}
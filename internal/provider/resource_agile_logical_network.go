@@ -112,7 +112,7 @@ func resourceAgileLogicalNetwork() *schema.Resource {
 func resourceAgileLogicalNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Logical Network: Beginning Creation")
 
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	id, _ := uuid.NewV4()
 
@@ -140,10 +140,10 @@ func resourceAgileLogicalNetworkCreate(ctx context.Context, d *schema.ResourceDa
 func resourceAgileLogicalNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	logicalNetwork, err := agileClient.GetLogicalNetwork(id)
+	logicalNetwork, err := getLogicalNetwork(agileMeta, id)
 
 	if err != nil {
 		d.SetId("")
@@ -162,7 +162,7 @@ func resourceAgileLogicalNetworkRead(ctx context.Context, d *schema.ResourceData
 
 func resourceAgileLogicalNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Logical Network: Beginning Update", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 
@@ -183,7 +183,7 @@ func resourceAgileLogicalNetworkUpdate(ctx context.Context, d *schema.ResourceDa
 
 func resourceAgileLogicalNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	err := agileClient.DeleteLogicalNetwork(d.Id())
 
@@ -231,10 +231,10 @@ func NewLogicalNetworkAttributes(d *schema.ResourceData) (*models.LogicalNetwork
 
 func resourceAgileLogicalNetworkImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	logicalNetwork, err := agileClient.GetLogicalNetwork(id)
+	logicalNetwork, err := getLogicalNetwork(agileMeta, id)
 
 	if err != nil {
 		return nil, err
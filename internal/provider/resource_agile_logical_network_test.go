@@ -139,7 +139,7 @@ func testAccCheckAgileLogicalNetworkExists(name string, logicalNetwork *models.L
 			return fmt.Errorf("no logical network id was set")
 		}
 
-		agileClient := testAccProvider.Meta().(*agile.Client)
+		agileClient := testAccProvider.Meta().(*providerMeta).client
 
 		logicalNetworkFound, err := agileClient.GetLogicalNetwork(rs.Primary.ID)
 		if err != nil {
@@ -193,7 +193,7 @@ func testAccCheckAgileLogicalNetworkAttributes(name string, logicalNetwork *mode
 }
 
 func testAccCheckAgileLogicalNetworkDestroy(s *terraform.State) error {
-	agileClient := testAccProvider.Meta().(*agile.Client)
+	agileClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 
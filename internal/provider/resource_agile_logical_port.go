@@ -68,6 +68,12 @@ func resourceAgileLogicalPort() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IsUUID,
 			},
+			"qos_policy_id": {
+				Type:         schema.TypeString,
+				Description:  "QoS policy applied to the logical port.",
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
 			"access_info": {
 				Type:        schema.TypeSet,
 				Description: "Access info Settings.",
@@ -231,7 +237,7 @@ func resourceAgileLogicalPort() *schema.Resource {
 func resourceAgileLogicalPortCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Logical Port: Beginning Creation")
 
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	id, _ := uuid.NewV4()
 
@@ -254,9 +260,9 @@ func resourceAgileLogicalPortCreate(ctx context.Context, d *schema.ResourceData,
 
 func resourceAgileLogicalPortRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Read Logical Port", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 	id := d.Id()
-	logicalPort, err := agileClient.GetLogicalPort(id)
+	logicalPort, err := getLogicalPort(agileMeta, id)
 
 	if err != nil {
 		d.SetId("")
@@ -273,7 +279,7 @@ func resourceAgileLogicalPortRead(ctx context.Context, d *schema.ResourceData, m
 
 func resourceAgileLogicalPortUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Logical Port: Beginning Update", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 
@@ -294,7 +300,7 @@ func resourceAgileLogicalPortUpdate(ctx context.Context, d *schema.ResourceData,
 
 func resourceAgileLogicalPortDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	err := agileClient.DeleteLogicalPort(d.Id())
 
@@ -328,6 +334,10 @@ func NewLogicalPortAttributes(d *schema.ResourceData) (*models.LogicalPortAttrib
 		logicalPortAttr.LogicSwitchId = agile.String(d.Get("logic_switch_id").(string))
 	}
 
+	if _, ok := d.GetOk("qos_policy_id"); ok {
+		logicalPortAttr.QosPolicyId = agile.String(d.Get("qos_policy_id").(string))
+	}
+
 	if _, ok := d.GetOk("access_info"); ok {
 		accessInfo := d.Get("access_info").(*schema.Set).List()[0].(map[string]interface{})
 		logicalPortAttr.AccessInfo = &models.LogicalPortAccessInfo{}
@@ -405,10 +415,10 @@ func NewLogicalPortAttributes(d *schema.ResourceData) (*models.LogicalPortAttrib
 
 func resourceAgileLogicalPortImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	logicalPort, err := agileClient.GetLogicalPort(id)
+	logicalPort, err := getLogicalPort(agileMeta, id)
 
 	if err != nil {
 		return nil, err
@@ -431,6 +441,7 @@ func setLogicalPortAttributes(logicalPort *models.LogicalPort, d *schema.Resourc
 	d.Set("tenant_id", *logicalPort.TenantId)
 	d.Set("fabric_id", logicalPort.FabricId)
 	d.Set("logic_switch_id", *logicalPort.LogicSwitchId)
+	d.Set("qos_policy_id", logicalPort.QosPolicyId)
 
 	accessInfo := []interface{}{
 		map[string]interface{}{
@@ -283,7 +283,7 @@ func testAccCheckAgileLogicalPortExists(name string, logicalPort *models.Logical
 			return fmt.Errorf("no logical port id was set")
 		}
 
-		agileClient := testAccProvider.Meta().(*agile.Client)
+		agileClient := testAccProvider.Meta().(*providerMeta).client
 
 		logicalPortFound, err := agileClient.GetLogicalPort(rs.Primary.ID)
 		if err != nil {
@@ -300,7 +300,7 @@ func testAccCheckAgileLogicalPortExists(name string, logicalPort *models.Logical
 }
 
 func testAccCheckAgileLogicalPortDestroy(s *terraform.State) error {
-	agileClient := testAccProvider.Meta().(*agile.Client)
+	agileClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 
@@ -2,14 +2,17 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	agile "github.com/claranet/agilec-go-client/client"
 	"github.com/claranet/agilec-go-client/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	uuid "github.com/nu7hatch/gouuid"
 	"log"
 	"regexp"
+	"strings"
 )
 
 func resourceAgileLogicalRouter() *schema.Resource {
@@ -84,22 +87,19 @@ func resourceAgileLogicalRouter() *schema.Resource {
 			},
 			"router_locations": {
 				Type:        schema.TypeSet,
-				Description: "Router Locations Settings.",
-				MaxItems:    1,
+				Description: "Router Locations Settings. Exactly one location must be the master, the other fabrics are backups. Adding or removing backup locations and device groups updates the router without recreating it, the complete location list is sent on every update. Changing the master fabric recreates the router.",
 				Required:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"fabric_id": {
 							Type:         schema.TypeString,
 							Description:  "Fabric ID",
-							ForceNew:     true,
 							Required:     true,
 							ValidateFunc: validation.IsUUID,
 						},
 						"fabric_role": {
 							Type:        schema.TypeString,
 							Description: "Fabric role, which can be master or backup.",
-							ForceNew:    true,
 							Optional:    true,
 							ValidateDiagFunc: validation.ToDiagFunc(
 								validation.StringInSlice([]string{"master", "backup"}, true),
@@ -133,33 +133,51 @@ func resourceAgileLogicalRouter() *schema.Resource {
 					},
 				},
 			},
-			//"additional": {
-			//	Type:        schema.TypeSet,
-			//	Description: "Additional Settings.",
-			//	Optional:    true,
-			//	MaxItems:    1,
-			//	Elem: &schema.Resource{
-			//		Schema: map[string]*schema.Schema{
-			//			"producer": {
-			//				Type:        schema.TypeString,
-			//				Optional:    true,
-			//				Description: "This parameter is optional. If it is specified by the user, the specified value is used. The character string starting with component is reserved. If no value is specified, the default value default is used.",
-			//				Default:     "default",
-			//				ValidateDiagFunc: validation.ToDiagFunc(
-			//					validation.StringLenBetween(0, 36),
-			//				),
-			//			},
-			//		},
-			//	},
-			//},
+			"additional": {
+				Type:        schema.TypeList,
+				Description: "Additional Settings.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"producer": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "This parameter is optional. If it is specified by the user, the specified value is used. The character string starting with component is reserved. If no value is specified, the default value default is used.",
+							Default:     "default",
+							ForceNew:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringLenBetween(0, 36),
+							),
+						},
+					},
+				},
+			},
 		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ValidateValue("router_locations", func(ctx context.Context, value, meta interface{}) error {
+				return validateLogicalRouterLocations(value.(*schema.Set).List())
+			}),
+			// Location changes are sent as a full update of the router, moving the master fabric
+			// recreates the router instead.
+			customdiff.ForceNewIf("router_locations", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				if d.Id() == "" || !d.HasChange("router_locations") {
+					return false
+				}
+
+				old, new := d.GetChange("router_locations")
+				return logicalRouterMasterFabric(old.(*schema.Set).List()) != logicalRouterMasterFabric(new.(*schema.Set).List())
+			}),
+		),
 	}
 }
 
 func resourceAgileLogicalRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Logical Router: Beginning Creation")
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
 
 	id, _ := uuid.NewV4()
 
@@ -171,24 +189,34 @@ func resourceAgileLogicalRouterCreate(ctx context.Context, d *schema.ResourceDat
 		return err
 	}
 
+	var diags diag.Diagnostics
+	if logicalRouter.Type != nil {
+		switch *logicalRouter.Type {
+		case "Transit", "Connect":
+			diags = agileMeta.featureWarning(featureLogicalRouterTransit, "Logical router type "+*logicalRouter.Type)
+		case "MultiActive":
+			diags = agileMeta.featureWarning(featureLogicalRouterMultiActive, "Logical router type MultiActive")
+		}
+	}
+
 	if err := agileClient.CreateLogicalRouter(agile.String(id.String()), agile.String(name), logicalRouter); err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 
 	d.SetId(id.String())
 	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
 
-	return resourceAgileLogicalRouterRead(ctx, d, meta)
+	return append(diags, resourceAgileLogicalRouterRead(ctx, d, meta)...)
 
 }
 
 func resourceAgileLogicalRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	logicalRouter, err := agileClient.GetLogicalRouter(id)
+	logicalRouter, err := getLogicalRouter(agileMeta, id)
 
 	if err != nil {
 		d.SetId("")
@@ -206,7 +234,8 @@ func resourceAgileLogicalRouterRead(ctx context.Context, d *schema.ResourceData,
 
 func resourceAgileLogicalRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Logical Router: Beginning Update", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
 
 	name := d.Get("name").(string)
 
@@ -216,6 +245,18 @@ func resourceAgileLogicalRouterUpdate(ctx context.Context, d *schema.ResourceDat
 		return err
 	}
 
+	// The logical router API has no call adding or removing a single location or device group: the
+	// client only offers Create, Get, Update and Delete on the whole router, and the PUT replaces its
+	// routerLocations list. Location changes are therefore sent as a full update built from what
+	// the controller currently holds.
+	if d.HasChange("router_locations") {
+		logicalRouter, err := getLogicalRouter(agileMeta, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		logicalRouterAttr.RouterLocations = mergeLogicalRouterLocations(d.Id(), logicalRouter.RouterLocations, logicalRouterAttr.RouterLocations)
+	}
+
 	if _, err := agileClient.UpdateLogicalRouter(agile.String(d.Id()), agile.String(name), logicalRouterAttr); err != nil {
 		return diag.FromErr(err)
 	}
@@ -225,7 +266,7 @@ func resourceAgileLogicalRouterUpdate(ctx context.Context, d *schema.ResourceDat
 
 func resourceAgileLogicalRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	if err := agileClient.DeleteLogicalRouter(d.Id()); err != nil {
 		return diag.FromErr(err)
@@ -263,43 +304,85 @@ func NewLogicalRouterAttributes(d *schema.ResourceData) (*models.LogicalRouterAt
 
 	if val, ok := d.GetOk("router_locations"); ok {
 		logicalRouterAttr.RouterLocations = make([]*models.LogicalRouterLocations, 0)
-		location := val.(*schema.Set).List()[0].(map[string]interface{})
-		var locationItem models.LogicalRouterLocations
+		for _, locationItem := range val.(*schema.Set).List() {
+			logicalRouterAttr.RouterLocations = append(logicalRouterAttr.RouterLocations, expandLogicalRouterLocation(locationItem.(map[string]interface{})))
+		}
+	}
 
-		if locationVal, ok := location["fabric_id"]; ok {
-			locationItem.FabricId = agile.String(locationVal.(string))
+	if val, ok := d.GetOk("additional"); ok {
+		additional := val.([]interface{})[0].(map[string]interface{})
+		logicalRouterAttr.Additional = &models.LogicalRouterAdditional{}
+		if val, ok := additional["producer"]; ok {
+			logicalRouterAttr.Additional.Producer = agile.String(val.(string))
 		}
+	}
 
-		if locationVal, ok := location["fabric_role"]; ok {
-			locationItem.FabricRole = agile.String(locationVal.(string))
+	return &logicalRouterAttr, nil
+
+}
+
+func expandLogicalRouterLocation(location map[string]interface{}) *models.LogicalRouterLocations {
+	var locationItem models.LogicalRouterLocations
+
+	if locationVal, ok := location["fabric_id"]; ok {
+		locationItem.FabricId = agile.String(locationVal.(string))
+	}
+
+	if locationVal, ok := location["fabric_role"]; ok && locationVal.(string) != "" {
+		locationItem.FabricRole = agile.String(locationVal.(string))
+	}
+
+	if deviceGroupVal, ok := location["device_group"]; ok {
+		routerDeviceGroup := make([]*models.LogicalRouterLocationsDeviceGroup, 0)
+		for _, deviceGroupItem := range deviceGroupVal.(*schema.Set).List() {
+			deviceGroup := deviceGroupItem.(map[string]interface{})
+			if val, ok := deviceGroup["device_id"]; ok {
+				routerDeviceGroup = append(routerDeviceGroup, &models.LogicalRouterLocationsDeviceGroup{
+					DeviceId: agile.String(val.(string)),
+				})
+			}
 		}
+		locationItem.DeviceGroup = routerDeviceGroup
+	}
 
-		if deviceGroupVal, ok := location["device_group"]; ok {
-			routerDeviceGroup := make([]*models.LogicalRouterLocationsDeviceGroup, 0)
-			for _, deviceGroupItem := range deviceGroupVal.(*schema.Set).List() {
-				deviceGroup := deviceGroupItem.(map[string]interface{})
-				if val, ok := deviceGroup["device_id"]; ok {
-					routerDeviceGroup = append(routerDeviceGroup, &models.LogicalRouterLocationsDeviceGroup{
-						DeviceIp: agile.String(val.(string)),
-					})
-				}
+	return &locationItem
+}
+
+// validateLogicalRouterLocations checks that the router locations use distinct fabrics and that
+// exactly one of them is the master. A single location without fabric_role is the master.
+func validateLogicalRouterLocations(locations []interface{}) error {
+	fabrics := make(map[string]bool)
+	masters := 0
+	for _, locationItem := range locations {
+		location := locationItem.(map[string]interface{})
+
+		fabricId := location["fabric_id"].(string)
+		if fabricId != "" {
+			if fabrics[fabricId] {
+				return fmt.Errorf("router_locations: fabric %s is used by more than one location", fabricId)
 			}
-			locationItem.DeviceGroup = routerDeviceGroup
+			fabrics[fabricId] = true
 		}
 
-		logicalRouterAttr.RouterLocations = append(logicalRouterAttr.RouterLocations, &locationItem)
+		role := location["fabric_role"].(string)
+		if strings.EqualFold(role, "master") || (role == "" && len(locations) == 1) {
+			masters++
+		}
 	}
 
-	return &logicalRouterAttr, nil
+	if len(locations) > 0 && masters != 1 {
+		return fmt.Errorf("router_locations: exactly one location must have fabric_role master, found %d", masters)
+	}
 
+	return nil
 }
 
 func resourceAgileLogicalRouterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	logicalRouter, err := agileClient.GetLogicalRouter(id)
+	logicalRouter, err := getLogicalRouter(agileMeta, id)
 
 	if err != nil {
 		return nil, err
@@ -323,28 +406,119 @@ func setLogicalRouterAttributes(logicalRouter *models.LogicalRouter, d *schema.R
 	d.Set("vrf_name", *logicalRouter.VrfName)
 	d.Set("type", *logicalRouter.Type)
 
-	var routerLocations []interface{}
-	var deviceGroups []interface{}
-	for _, deviceGroup := range logicalRouter.RouterLocations[0].DeviceGroup {
-		deviceGroups = append(deviceGroups, map[string]interface{}{
-			"device_id": *deviceGroup.DeviceId,
-			"device_ip": *deviceGroup.DeviceIp,
+	d.Set("router_locations", flattenLogicalRouterLocations(logicalRouter.RouterLocations))
+
+	if logicalRouter.Additional != nil {
+		err := d.Set("additional", []interface{}{
+			map[string]interface{}{
+				"producer": logicalRouter.Additional.Producer,
+			},
 		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	routerLocations = append(routerLocations, map[string]interface{}{
-		"fabric_role":  *logicalRouter.RouterLocations[0].FabricRole,
-		"fabric_id":    *logicalRouter.RouterLocations[0].FabricId,
-		"fabric_name":  *logicalRouter.RouterLocations[0].FabricName,
-		"device_group": deviceGroups,
-	})
-	d.Set("router_locations", routerLocations)
-	//if _, ok := d.GetOk("additional"); ok {
-	//	d.Set("additional", []interface{}{
-	//		map[string]string{
-	//			"producer": *logicalRouter.Additional.Producer,
-	//		},
-	//	})
-	//}
 	return d, nil
 }
+
+// logicalRouterMasterFabric returns the fabric of the master location, following the rules of
+// validateLogicalRouterLocations.
+func logicalRouterMasterFabric(locations []interface{}) string {
+	for _, locationItem := range locations {
+		location := locationItem.(map[string]interface{})
+		role := location["fabric_role"].(string)
+		if strings.EqualFold(role, "master") || (role == "" && len(locations) == 1) {
+			return location["fabric_id"].(string)
+		}
+	}
+	return ""
+}
+
+// mergeLogicalRouterLocations builds the full location list sent by UpdateLogicalRouter, which
+// replaces every location of the router. Locations and device groups that are kept are sent back
+// as the controller returned them, added ones as configured, and removed ones are left out.
+func mergeLogicalRouterLocations(id string, current []*models.LogicalRouterLocations, desired []*models.LogicalRouterLocations) []*models.LogicalRouterLocations {
+	currentLocations := make(map[string]*models.LogicalRouterLocations)
+	for _, location := range current {
+		if location != nil && location.FabricId != nil {
+			currentLocations[*location.FabricId] = location
+		}
+	}
+
+	locations := make([]*models.LogicalRouterLocations, 0, len(desired))
+	for _, location := range desired {
+		currentLocation, ok := currentLocations[*location.FabricId]
+		if !ok {
+			log.Printf("[DEBUG] %s: Adding router location on fabric %s", id, *location.FabricId)
+			locations = append(locations, location)
+			continue
+		}
+		delete(currentLocations, *location.FabricId)
+
+		merged := *currentLocation
+		if location.FabricRole != nil {
+			merged.FabricRole = location.FabricRole
+		}
+
+		currentDevices := make(map[string]*models.LogicalRouterLocationsDeviceGroup)
+		for _, deviceGroup := range currentLocation.DeviceGroup {
+			if deviceGroup != nil && deviceGroup.DeviceId != nil {
+				currentDevices[*deviceGroup.DeviceId] = deviceGroup
+			}
+		}
+
+		merged.DeviceGroup = make([]*models.LogicalRouterLocationsDeviceGroup, 0, len(location.DeviceGroup))
+		for _, deviceGroup := range location.DeviceGroup {
+			if currentDevice, ok := currentDevices[*deviceGroup.DeviceId]; ok {
+				merged.DeviceGroup = append(merged.DeviceGroup, currentDevice)
+				delete(currentDevices, *deviceGroup.DeviceId)
+				continue
+			}
+			log.Printf("[DEBUG] %s: Adding device %s to router location on fabric %s", id, *deviceGroup.DeviceId, *location.FabricId)
+			merged.DeviceGroup = append(merged.DeviceGroup, deviceGroup)
+		}
+
+		for deviceId := range currentDevices {
+			log.Printf("[DEBUG] %s: Removing device %s from router location on fabric %s", id, deviceId, *location.FabricId)
+		}
+
+		locations = append(locations, &merged)
+	}
+
+	for fabricId := range currentLocations {
+		log.Printf("[DEBUG] %s: Removing router location on fabric %s", id, fabricId)
+	}
+
+	return locations
+}
+
+// flattenLogicalRouterLocations converts every location of a logical router, master and backups,
+// into the router_locations set shared by the resource and the data source.
+func flattenLogicalRouterLocations(locations []*models.LogicalRouterLocations) []interface{} {
+	routerLocations := make([]interface{}, 0, len(locations))
+	for _, location := range locations {
+		if location == nil {
+			continue
+		}
+
+		deviceGroups := make([]interface{}, 0, len(location.DeviceGroup))
+		for _, deviceGroup := range location.DeviceGroup {
+			if deviceGroup == nil {
+				continue
+			}
+			deviceGroups = append(deviceGroups, map[string]interface{}{
+				"device_id": deviceGroup.DeviceId,
+				"device_ip": deviceGroup.DeviceIp,
+			})
+		}
+
+		routerLocations = append(routerLocations, map[string]interface{}{
+			"fabric_role":  location.FabricRole,
+			"fabric_id":    location.FabricId,
+			"fabric_name":  location.FabricName,
+			"device_group": deviceGroups,
+		})
+	}
+	return routerLocations
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/claranet/agilec-go-client/models"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
 	"testing"
 )
 
@@ -24,9 +25,9 @@ func TestAccAgileLogicalRouter_Complete(t *testing.T) {
 				FabricRole: agile.String("master"),
 			},
 		},
-		//Additional: &models.LogicalRouterAdditional{
-		//	Producer: agile.String("Terraform"),
-		//},
+		Additional: &models.LogicalRouterAdditional{
+			Producer: agile.String("Terraform"),
+		},
 	}
 
 	resourceName := "agile_logical_router.this"
@@ -51,8 +52,8 @@ func TestAccAgileLogicalRouter_Complete(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "router_locations.#", fmt.Sprint(len(logicalRouterAttr.RouterLocations))),
 					resource.TestCheckResourceAttr(resourceName, "router_locations.0.fabric_role", *logicalRouterAttr.RouterLocations[0].FabricRole),
 					resource.TestCheckResourceAttr(resourceName, "router_locations.0.fabric_id", *logicalRouterAttr.RouterLocations[0].FabricId),
-					//resource.TestCheckResourceAttr(resourceName, "additional.#", "1"),
-					//resource.TestCheckResourceAttr(resourceName, "additional.0.producer", *logicalRouterAttr.Additional.Producer),
+					resource.TestCheckResourceAttr(resourceName, "additional.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional.0.producer", *logicalRouterAttr.Additional.Producer),
 				),
 			},
 			{
@@ -80,9 +81,9 @@ func TestAccAgileLogicalRouter_Update(t *testing.T) {
 				FabricRole: agile.String("master"),
 			},
 		},
-		//Additional: &models.LogicalRouterAdditional{
-		//	Producer: agile.String("Terraform"),
-		//},
+		Additional: &models.LogicalRouterAdditional{
+			Producer: agile.String("Terraform"),
+		},
 	}
 
 	logicalRouterUpdate := logicalRouterAttr
@@ -119,7 +120,169 @@ func TestAccAgileLogicalRouter_Update(t *testing.T) {
 	})
 }
 
+func TestAccAgileLogicalRouter_MultipleLocations(t *testing.T) {
+	name := "tf_acc_tests_logicalRouter"
+
+	logicalRouterAttr := models.LogicalRouterAttributes{
+		Description:    agile.String("Logical Router created via Terraform Tests"),
+		LogicNetworkId: agile.String("acfd8aaf-c6dc-499d-8020-bebd85b1f0e6"),
+		Type:           agile.String("Normal"),
+		VrfName:        agile.String("Management_67766776"),
+		Vni:            agile.Int32(4226),
+		RouterLocations: []*models.LogicalRouterLocations{
+			{
+				FabricId:   agile.String("f1429224-1860-4bdb-8cc8-98ccc0f5563a"),
+				FabricRole: agile.String("master"),
+			},
+			{
+				FabricId:   agile.String("804c7c74-5586-48bf-9cea-96a6d4d3f3a5"),
+				FabricRole: agile.String("backup"),
+			},
+		},
+	}
+
+	resourceName := "agile_logical_router.this"
+	var logicalRouter models.LogicalRouter
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileLogicalRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcLogicalRouterConfig_Complete(name, &logicalRouterAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileLogicalRouterExists(resourceName, &logicalRouter),
+					testAccCheckAgileLogicalRouterAttributes(name, &logicalRouter, &logicalRouterAttr),
+					resource.TestCheckResourceAttr(resourceName, "router_locations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "router_locations.*", map[string]string{
+						"fabric_id":   *logicalRouterAttr.RouterLocations[0].FabricId,
+						"fabric_role": "master",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "router_locations.*", map[string]string{
+						"fabric_id":   *logicalRouterAttr.RouterLocations[1].FabricId,
+						"fabric_role": "backup",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAgileLogicalRouter_UpdateLocations(t *testing.T) {
+	name := "tf_acc_tests_logicalRouter"
+
+	logicalRouterAttr := models.LogicalRouterAttributes{
+		Description:    agile.String("Logical Router created via Terraform Tests"),
+		LogicNetworkId: agile.String("acfd8aaf-c6dc-499d-8020-bebd85b1f0e6"),
+		Type:           agile.String("Normal"),
+		VrfName:        agile.String("Management_67766776"),
+		Vni:            agile.Int32(4226),
+		RouterLocations: []*models.LogicalRouterLocations{
+			{
+				FabricId:   agile.String("f1429224-1860-4bdb-8cc8-98ccc0f5563a"),
+				FabricRole: agile.String("master"),
+			},
+		},
+	}
+
+	logicalRouterUpdate := logicalRouterAttr
+	logicalRouterUpdate.RouterLocations = append(logicalRouterAttr.RouterLocations[:1:1], &models.LogicalRouterLocations{
+		FabricId:   agile.String("804c7c74-5586-48bf-9cea-96a6d4d3f3a5"),
+		FabricRole: agile.String("backup"),
+	})
+
+	resourceName := "agile_logical_router.this"
+	var logicalRouter models.LogicalRouter
+	var logicalRouterUpdated models.LogicalRouter
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileLogicalRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcLogicalRouterConfig_Complete(name, &logicalRouterAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileLogicalRouterExists(resourceName, &logicalRouter),
+					resource.TestCheckResourceAttr(resourceName, "router_locations.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckAcLogicalRouterConfig_Complete(name, &logicalRouterUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileLogicalRouterExists(resourceName, &logicalRouterUpdated),
+					testAccCheckAgileLogicalRouterAttributes(name, &logicalRouterUpdated, &logicalRouterUpdate),
+					testAccCheckAgileLogicalRouterNotRecreated(&logicalRouter, &logicalRouterUpdated),
+					resource.TestCheckResourceAttr(resourceName, "router_locations.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckAcLogicalRouterConfig_Complete(name, &logicalRouterAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileLogicalRouterExists(resourceName, &logicalRouterUpdated),
+					testAccCheckAgileLogicalRouterNotRecreated(&logicalRouter, &logicalRouterUpdated),
+					resource.TestCheckResourceAttr(resourceName, "router_locations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgileLogicalRouter_TwoMasters(t *testing.T) {
+	logicalRouterAttr := models.LogicalRouterAttributes{
+		Description:    agile.String("Logical Router created via Terraform Tests"),
+		LogicNetworkId: agile.String("acfd8aaf-c6dc-499d-8020-bebd85b1f0e6"),
+		Type:           agile.String("Normal"),
+		VrfName:        agile.String("Management_67766776"),
+		Vni:            agile.Int32(4226),
+		RouterLocations: []*models.LogicalRouterLocations{
+			{
+				FabricId:   agile.String("f1429224-1860-4bdb-8cc8-98ccc0f5563a"),
+				FabricRole: agile.String("master"),
+			},
+			{
+				FabricId:   agile.String("804c7c74-5586-48bf-9cea-96a6d4d3f3a5"),
+				FabricRole: agile.String("master"),
+			},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAcLogicalRouterConfig_Complete("tf_acc_tests_logicalRouter", &logicalRouterAttr),
+				ExpectError: regexp.MustCompile("exactly one location must have fabric_role master, found 2"),
+			},
+		},
+	})
+}
+
 func testAccCheckAcLogicalRouterConfig_Complete(name string, logicalRouter *models.LogicalRouterAttributes) string {
+	routerLocations := ""
+	for _, location := range logicalRouter.RouterLocations {
+		routerLocations += fmt.Sprintf(`
+      router_locations {
+		fabric_id = "%s"
+		fabric_role = "%s"
+      }`, *location.FabricId, *location.FabricRole)
+	}
+
+	additional := ""
+	if logicalRouter.Additional != nil {
+		additional = fmt.Sprintf(`
+	 additional {
+		producer = "%s"
+	 }`, *logicalRouter.Additional.Producer)
+	}
+
 	return fmt.Sprintf(`
 	resource "agile_logical_router" "this" {
 	  name        = "%s"
@@ -127,14 +290,10 @@ func testAccCheckAcLogicalRouterConfig_Complete(name string, logicalRouter *mode
 	  logic_network_id   = "%s"
 	  type  = "%s"
       vrf_name = "%s"
-      vni = "%d"
-      router_locations {
-		fabric_id = "%s"
-		fabric_role = "%s"
-      }
+      vni = "%d"%s%s
 	}
 	`, name, *logicalRouter.Description, *logicalRouter.LogicNetworkId, *logicalRouter.Type, *logicalRouter.VrfName, *logicalRouter.Vni,
-		*logicalRouter.RouterLocations[0].FabricId, *logicalRouter.RouterLocations[0].FabricRole)
+		routerLocations, additional)
 }
 
 func testAccCheckAgileLogicalRouterExists(name string, logicalRouter *models.LogicalRouter) resource.TestCheckFunc {
@@ -149,7 +308,7 @@ func testAccCheckAgileLogicalRouterExists(name string, logicalRouter *models.Log
 			return fmt.Errorf("no logical router id was set")
 		}
 
-		agileClient := testAccProvider.Meta().(*agile.Client)
+		agileClient := testAccProvider.Meta().(*providerMeta).client
 
 		logicalRouterFound, err := agileClient.GetLogicalRouter(rs.Primary.ID)
 		if err != nil {
@@ -176,11 +335,11 @@ func testAccCheckAgileLogicalRouterAttributes(name string, logicalRouter *models
 			return fmt.Errorf("bad logical router description %s", *logicalRouter.Description)
 		}
 
-		//if attributes.Additional != nil {
-		//	if *logicalRouter.Additional.Producer != *attributes.Additional.Producer {
-		//		return fmt.Errorf("bad logical router producer %s", *logicalRouter.Additional.Producer)
-		//	}
-		//}
+		if attributes.Additional != nil {
+			if logicalRouter.Additional == nil || *logicalRouter.Additional.Producer != *attributes.Additional.Producer {
+				return fmt.Errorf("bad logical router producer")
+			}
+		}
 
 		if attributes.Vni != nil && *logicalRouter.Vni != *attributes.Vni {
 			return fmt.Errorf("bad logical router vni %d", *logicalRouter.Vni)
@@ -198,25 +357,46 @@ func testAccCheckAgileLogicalRouterAttributes(name string, logicalRouter *models
 			return fmt.Errorf("bad logical router network id %s", *logicalRouter.LogicNetworkId)
 		}
 
-		for i, location := range attributes.RouterLocations {
+		if len(logicalRouter.RouterLocations) != len(attributes.RouterLocations) {
+			return fmt.Errorf("bad logical router router locations count %d", len(logicalRouter.RouterLocations))
+		}
 
-			if location.FabricName != nil && *logicalRouter.RouterLocations[i].FabricName != *location.FabricName {
-				return fmt.Errorf("bad logical router router location fabric name %s", *logicalRouter.RouterLocations[i].FabricName)
+		for _, location := range attributes.RouterLocations {
+			var found *models.LogicalRouterLocations
+			for _, routerLocation := range logicalRouter.RouterLocations {
+				if *routerLocation.FabricId == *location.FabricId {
+					found = routerLocation
+				}
 			}
 
-			if location.FabricRole != nil && *logicalRouter.RouterLocations[i].FabricRole != *location.FabricRole {
-				return fmt.Errorf("bad logical router router location fabric role %s", *logicalRouter.RouterLocations[i].FabricRole)
+			if found == nil {
+				return fmt.Errorf("logical router router location fabric %s not found", *location.FabricId)
 			}
 
-			// TODO Falta Router Locations
+			if location.FabricName != nil && *found.FabricName != *location.FabricName {
+				return fmt.Errorf("bad logical router router location fabric name %s", *found.FabricName)
+			}
+
+			if location.FabricRole != nil && *found.FabricRole != *location.FabricRole {
+				return fmt.Errorf("bad logical router router location fabric role %s", *found.FabricRole)
+			}
 		}
 
 		return nil
 	}
 }
 
+func testAccCheckAgileLogicalRouterNotRecreated(before, after *models.LogicalRouter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before.Id != *after.Id {
+			return fmt.Errorf("logical router was recreated, id changed from %s to %s", *before.Id, *after.Id)
+		}
+		return nil
+	}
+}
+
 func testAccCheckAgileLogicalRouterDestroy(s *terraform.State) error {
-	agileClient := testAccProvider.Meta().(*agile.Client)
+	agileClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 
@@ -238,3 +418,78 @@ func testAccCheckAgileLogicalRouterDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestMergeLogicalRouterLocations(t *testing.T) {
+	current := []*models.LogicalRouterLocations{
+		{
+			FabricId:   agile.String("f1429224-1860-4bdb-8cc8-98ccc0f5563a"),
+			FabricRole: agile.String("master"),
+			FabricName: agile.String("fabric-a"),
+			DeviceGroup: []*models.LogicalRouterLocationsDeviceGroup{
+				{DeviceId: agile.String("9e3a5bee-3d95-3bf7-90f5-09bd2177324b"), DeviceIp: agile.String("10.0.0.1")},
+				{DeviceId: agile.String("1a7d6f0e-6b5c-3c1d-8f2e-4b9a0c7d5e11"), DeviceIp: agile.String("10.0.0.2")},
+			},
+		},
+	}
+
+	desired := []*models.LogicalRouterLocations{
+		{
+			FabricId:   agile.String("f1429224-1860-4bdb-8cc8-98ccc0f5563a"),
+			FabricRole: agile.String("master"),
+			DeviceGroup: []*models.LogicalRouterLocationsDeviceGroup{
+				{DeviceId: agile.String("9e3a5bee-3d95-3bf7-90f5-09bd2177324b")},
+			},
+		},
+		{
+			FabricId:   agile.String("804c7c74-5586-48bf-9cea-96a6d4d3f3a5"),
+			FabricRole: agile.String("backup"),
+		},
+	}
+
+	locations := mergeLogicalRouterLocations("router", current, desired)
+
+	if len(locations) != 2 {
+		t.Fatalf("got %d locations, want 2", len(locations))
+	}
+
+	master := locations[0]
+	if master.FabricName == nil || *master.FabricName != "fabric-a" {
+		t.Errorf("kept location lost the fabric name returned by the controller")
+	}
+
+	if len(master.DeviceGroup) != 1 || *master.DeviceGroup[0].DeviceId != "9e3a5bee-3d95-3bf7-90f5-09bd2177324b" ||
+		master.DeviceGroup[0].DeviceIp == nil || *master.DeviceGroup[0].DeviceIp != "10.0.0.1" {
+		t.Errorf("got device group %+v, want the kept device as returned by the controller", master.DeviceGroup)
+	}
+
+	if *locations[1].FabricId != "804c7c74-5586-48bf-9cea-96a6d4d3f3a5" || *locations[1].FabricRole != "backup" {
+		t.Errorf("added backup location not sent as configured")
+	}
+
+	if len(current[0].DeviceGroup) != 2 {
+		t.Errorf("the controller locations were modified")
+	}
+}
+
+func TestLogicalRouterMasterFabric(t *testing.T) {
+	cases := []struct {
+		locations []interface{}
+		want      string
+	}{
+		{[]interface{}{map[string]interface{}{"fabric_id": "a", "fabric_role": ""}}, "a"},
+		{[]interface{}{
+			map[string]interface{}{"fabric_id": "a", "fabric_role": "backup"},
+			map[string]interface{}{"fabric_id": "b", "fabric_role": "Master"},
+		}, "b"},
+		{[]interface{}{
+			map[string]interface{}{"fabric_id": "a", "fabric_role": ""},
+			map[string]interface{}{"fabric_id": "b", "fabric_role": "backup"},
+		}, ""},
+	}
+
+	for i, c := range cases {
+		if got := logicalRouterMasterFabric(c.locations); got != c.want {
+			t.Errorf("case %d: logicalRouterMasterFabric = %q, want %q", i, got, c.want)
+		}
+	}
+}
@@ -87,6 +87,12 @@ func resourceAgileLogicalSwitch() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.IsUUID,
 			},
+			"qos_policy_id": {
+				Type:         schema.TypeString,
+				Description:  "QoS policy applied to the logical switch.",
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
 			"storm_suppress": {
 				Type:        schema.TypeList,
 				Description: "Storm Suppress Settings.",
@@ -216,7 +222,8 @@ func resourceAgileLogicalSwitch() *schema.Resource {
 func resourceAgileLogicalSwitchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Logical Switcg: Beginning Creation")
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
 
 	id, _ := uuid.NewV4()
 
@@ -242,10 +249,10 @@ func resourceAgileLogicalSwitchCreate(ctx context.Context, d *schema.ResourceDat
 func resourceAgileLogicalSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	logicalSwitch, err := agileClient.GetLogicalSwitch(id)
+	logicalSwitch, err := getLogicalSwitch(agileMeta, id)
 
 	if err != nil {
 		d.SetId("")
@@ -263,7 +270,8 @@ func resourceAgileLogicalSwitchRead(ctx context.Context, d *schema.ResourceData,
 
 func resourceAgileLogicalSwitchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Logical Switch: Beginning Update", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
 
 	name := d.Get("name").(string)
 
@@ -282,7 +290,7 @@ func resourceAgileLogicalSwitchUpdate(ctx context.Context, d *schema.ResourceDat
 
 func resourceAgileLogicalSwitchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	if err := agileClient.DeleteLogicalSwitch(d.Id()); err != nil {
 		return diag.FromErr(err)
@@ -297,10 +305,10 @@ func resourceAgileLogicalSwitchDelete(ctx context.Context, d *schema.ResourceDat
 
 func resourceAgileLogicalSwitchImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	logicalSwitch, err := agileClient.GetLogicalSwitch(id)
+	logicalSwitch, err := getLogicalSwitch(agileMeta, id)
 
 	if err != nil {
 		return nil, err
@@ -343,6 +351,10 @@ func NewLogicalSwitchAttributes(d *schema.ResourceData) (*models.LogicalSwitchAt
 		logicalSwitchAttr.TenantId = agile.String(d.Get("tenant_id").(string))
 	}
 
+	if _, ok := d.GetOk("qos_policy_id"); ok {
+		logicalSwitchAttr.QosPolicyId = agile.String(d.Get("qos_policy_id").(string))
+	}
+
 	if val, ok := d.GetOk("storm_suppress"); ok {
 		stormSuppress := val.([]interface{})[0].(map[string]interface{})
 		logicalSwitchAttr.StormSuppress = &models.LogicalSwitchStormSuppress{}
@@ -427,6 +439,9 @@ func setLogicalSwitchAttributes(logicalSwitch *models.LogicalSwitch, d *schema.R
 	if err := d.Set("mac_address", logicalSwitch.MacAddress); err != nil {
 		return nil, err
 	}
+	if err := d.Set("qos_policy_id", logicalSwitch.QosPolicyId); err != nil {
+		return nil, err
+	}
 
 	if logicalSwitch.StormSuppress != nil {
 		stormSuppress := []interface{}{
@@ -212,7 +212,7 @@ func testAccCheckAgileLogicalSwitchExists(name string, logicalSwitch *models.Log
 			return fmt.Errorf("no logical switch id was set")
 		}
 
-		agileClient := testAccProvider.Meta().(*agile.Client)
+		agileClient := testAccProvider.Meta().(*providerMeta).client
 
 		logicalSwitchFound, err := agileClient.GetLogicalSwitch(rs.Primary.ID)
 		if err != nil {
@@ -332,7 +332,7 @@ func testAccCheckAgileLogicalSwitchAttributes(name string, logicalSwitch *models
 }
 
 func testAccCheckAgileLogicalSwitchDestroy(s *terraform.State) error {
-	agileClient := testAccProvider.Meta().(*agile.Client)
+	agileClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 
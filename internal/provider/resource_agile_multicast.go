@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/nu7hatch/gouuid"
+	"terraform-provider-agile/tools"
+)
+
+func resourceAgileMulticast() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the multicast configuration (PIM, IGMP, rendezvous points and multicast ranges) of a logical router. The logical network must have the multicast capability enabled.",
+		CreateContext: resourceAgileMulticastCreate,
+		ReadContext:   resourceAgileMulticastRead,
+		UpdateContext: resourceAgileMulticastUpdate,
+		DeleteContext: resourceAgileMulticastDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgileMulticastImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "Multicast configuration ID.",
+				Computed:    true,
+			},
+			"logic_network_id": {
+				Type:         schema.TypeString,
+				Description:  "Logical network where the multicast is configured. The multicast capability must be enabled on the logical network.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"logic_router_id": {
+				Type:         schema.TypeString,
+				Description:  "Logical router on which the multicast is configured.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"pim": {
+				Type:        schema.TypeList,
+				Description: "PIM Settings.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Description:  "PIM mode, which can be sm or ssm. At least one rendezvous point is required in sm mode.",
+							Optional:     true,
+							Default:      "sm",
+							ValidateFunc: validation.StringInSlice([]string{"sm", "ssm"}, false),
+						},
+						"hello_interval": {
+							Type:         schema.TypeInt,
+							Description:  "Interval for sending PIM Hello messages, in seconds.",
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntBetween(1, 18000),
+						},
+						"join_prune_interval": {
+							Type:         schema.TypeInt,
+							Description:  "Interval for sending PIM Join/Prune messages, in seconds.",
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntBetween(1, 18000),
+						},
+					},
+				},
+			},
+			"igmp": {
+				Type:        schema.TypeList,
+				Description: "IGMP Settings.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:         schema.TypeInt,
+							Description:  "IGMP version, which can be 1, 2 or 3.",
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(1, 3),
+						},
+						"query_interval": {
+							Type:         schema.TypeInt,
+							Description:  "Interval for sending IGMP general query messages, in seconds.",
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntBetween(1, 18000),
+						},
+						"robust_count": {
+							Type:         schema.TypeInt,
+							Description:  "IGMP robustness variable.",
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(2, 5),
+						},
+					},
+				},
+			},
+			"rendezvous_point": {
+				Type:        schema.TypeSet,
+				Description: "Static rendezvous points.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Description:  "Rendezvous point IPv4 address.",
+							Required:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"group_range": {
+							Type:         schema.TypeString,
+							Description:  "Multicast group range served by the rendezvous point, in CIDR notation.",
+							Optional:     true,
+							Default:      "224.0.0.0/4",
+							ValidateFunc: validation.IsCIDR,
+						},
+					},
+				},
+			},
+			"source_ranges": {
+				Type:        schema.TypeSet,
+				Description: "Multicast source ranges, in CIDR notation.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"group_ranges": {
+				Type:        schema.TypeSet,
+				Description: "Multicast group ranges, in CIDR notation.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"acl_id": {
+				Type:         schema.TypeString,
+				Description:  "ACL used to filter the multicast groups that hosts can join.",
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func resourceAgileMulticastCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Multicast: Beginning Creation")
+
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
+
+	id, _ := uuid.NewV4()
+
+	multicast, err := NewMulticastAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	logicalNetwork, errNetwork := getLogicalNetwork(agileMeta, *multicast.LogicNetworkId)
+
+	if errNetwork != nil {
+		return diag.FromErr(errNetwork)
+	}
+
+	if logicalNetwork.MulticastCapability == nil || !*logicalNetwork.MulticastCapability {
+		return diag.Errorf("Multicast capability is not enabled on logical network %s.", *multicast.LogicNetworkId)
+	}
+
+	if err := agileClient.CreateMulticast(agile.String(id.String()), multicast); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceAgileMulticastRead(ctx, d, meta)
+}
+
+func resourceAgileMulticastRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	multicast, err := getMulticast(agileMeta, id)
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	if _, err := setMulticastAttributes(multicast, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+func resourceAgileMulticastUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Multicast: Beginning Update", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	multicastAttr, err := NewMulticastAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := agileClient.UpdateMulticast(agile.String(d.Id()), multicastAttr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAgileMulticastRead(ctx, d, meta)
+}
+
+func resourceAgileMulticastDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	if err := agileClient.DeleteMulticast(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+
+	d.SetId("")
+
+	return diag.FromErr(nil)
+}
+
+func resourceAgileMulticastImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	multicast, err := getMulticast(agileMeta, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schemaFilled, err := setMulticastAttributes(multicast, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+
+	return []*schema.ResourceData{schemaFilled}, nil
+}
+
+func NewMulticastAttributes(d *schema.ResourceData) (*models.MulticastAttributes, diag.Diagnostics) {
+	multicastAttr := models.MulticastAttributes{
+		LogicNetworkId: agile.String(d.Get("logic_network_id").(string)),
+		LogicRouterId:  agile.String(d.Get("logic_router_id").(string)),
+	}
+
+	if val, ok := d.GetOk("pim"); ok {
+		pim := val.([]interface{})[0].(map[string]interface{})
+		multicastAttr.Pim = &models.MulticastPim{
+			Mode:              agile.String(pim["mode"].(string)),
+			HelloInterval:     agile.Int32(int32(pim["hello_interval"].(int))),
+			JoinPruneInterval: agile.Int32(int32(pim["join_prune_interval"].(int))),
+		}
+	}
+
+	if val, ok := d.GetOk("igmp"); ok {
+		igmp := val.([]interface{})[0].(map[string]interface{})
+		multicastAttr.Igmp = &models.MulticastIgmp{
+			Version:       agile.Int32(int32(igmp["version"].(int))),
+			QueryInterval: agile.Int32(int32(igmp["query_interval"].(int))),
+			RobustCount:   agile.Int32(int32(igmp["robust_count"].(int))),
+		}
+	}
+
+	multicastAttr.RendezvousPoints = make([]*models.MulticastRendezvousPoint, 0)
+	if val, ok := d.GetOk("rendezvous_point"); ok {
+		for _, item := range val.(*schema.Set).List() {
+			rendezvousPoint := item.(map[string]interface{})
+			multicastAttr.RendezvousPoints = append(multicastAttr.RendezvousPoints, &models.MulticastRendezvousPoint{
+				Address:    agile.String(rendezvousPoint["address"].(string)),
+				GroupRange: agile.String(rendezvousPoint["group_range"].(string)),
+			})
+		}
+	}
+
+	if (multicastAttr.Pim == nil || *multicastAttr.Pim.Mode == "sm") && len(multicastAttr.RendezvousPoints) == 0 {
+		return nil, diag.Errorf("At least one rendezvous_point must be set when the PIM mode is sm.")
+	}
+
+	if val, ok := d.GetOk("source_ranges"); ok {
+		multicastAttr.SourceRanges = tools.ExtractSliceOfStrings(val.(*schema.Set).List())
+	}
+
+	if val, ok := d.GetOk("group_ranges"); ok {
+		multicastAttr.GroupRanges = tools.ExtractSliceOfStrings(val.(*schema.Set).List())
+	}
+
+	if _, ok := d.GetOk("acl_id"); ok {
+		multicastAttr.AclId = agile.String(d.Get("acl_id").(string))
+	}
+
+	return &multicastAttr, nil
+}
+
+func setMulticastAttributes(multicast *models.Multicast, d *schema.ResourceData) (*schema.ResourceData, error) {
+	if err := d.Set("logic_network_id", multicast.LogicNetworkId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("logic_router_id", multicast.LogicRouterId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("acl_id", multicast.AclId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("source_ranges", tools.CreateSliceOfStrings(multicast.SourceRanges)); err != nil {
+		return nil, err
+	}
+	if err := d.Set("group_ranges", tools.CreateSliceOfStrings(multicast.GroupRanges)); err != nil {
+		return nil, err
+	}
+
+	if multicast.Pim != nil {
+		err := d.Set("pim", []interface{}{
+			map[string]interface{}{
+				"mode":                multicast.Pim.Mode,
+				"hello_interval":      multicast.Pim.HelloInterval,
+				"join_prune_interval": multicast.Pim.JoinPruneInterval,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if multicast.Igmp != nil {
+		err := d.Set("igmp", []interface{}{
+			map[string]interface{}{
+				"version":        multicast.Igmp.Version,
+				"query_interval": multicast.Igmp.QueryInterval,
+				"robust_count":   multicast.Igmp.RobustCount,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rendezvousPoints []interface{}
+	for _, rendezvousPoint := range multicast.RendezvousPoints {
+		rendezvousPoints = append(rendezvousPoints, map[string]interface{}{
+			"address":     rendezvousPoint.Address,
+			"group_range": rendezvousPoint.GroupRange,
+		})
+	}
+	if err := d.Set("rendezvous_point", rendezvousPoints); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"fmt"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccAgileMulticast_Complete(t *testing.T) {
+	multicastAttr := models.MulticastAttributes{
+		LogicNetworkId: agile.String("5308df55-1709-404f-b4f8-4d8947d8f0c4"),
+		LogicRouterId:  agile.String("78750ef6-d054-4181-9143-4640dff220e1"),
+		Pim: &models.MulticastPim{
+			Mode:              agile.String("sm"),
+			HelloInterval:     agile.Int32(30),
+			JoinPruneInterval: agile.Int32(60),
+		},
+		Igmp: &models.MulticastIgmp{
+			Version:       agile.Int32(3),
+			QueryInterval: agile.Int32(60),
+			RobustCount:   agile.Int32(2),
+		},
+		RendezvousPoints: []*models.MulticastRendezvousPoint{
+			{
+				Address:    agile.String("10.10.10.1"),
+				GroupRange: agile.String("239.0.0.0/8"),
+			},
+		},
+		GroupRanges: []*string{agile.String("239.1.0.0/16")},
+	}
+
+	multicastUpdate := multicastAttr
+	multicastUpdate.Igmp = &models.MulticastIgmp{
+		Version:       agile.Int32(2),
+		QueryInterval: agile.Int32(125),
+		RobustCount:   agile.Int32(3),
+	}
+
+	resourceName := "agile_multicast.this"
+	var multicast models.Multicast
+	var multicastUpdated models.Multicast
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileMulticastDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcMulticastConfig_Complete(&multicastAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileMulticastExists(resourceName, &multicast),
+					testAccCheckAgileMulticastAttributes(&multicast, &multicastAttr),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "logic_network_id", *multicastAttr.LogicNetworkId),
+					resource.TestCheckResourceAttr(resourceName, "logic_router_id", *multicastAttr.LogicRouterId),
+					resource.TestCheckResourceAttr(resourceName, "pim.0.mode", *multicastAttr.Pim.Mode),
+					resource.TestCheckResourceAttr(resourceName, "igmp.0.version", fmt.Sprint(*multicastAttr.Igmp.Version)),
+					resource.TestCheckResourceAttr(resourceName, "rendezvous_point.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group_ranges.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			{
+				Config: testAccCheckAcMulticastConfig_Complete(&multicastUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileMulticastExists(resourceName, &multicastUpdated),
+					testAccCheckAgileMulticastAttributes(&multicastUpdated, &multicastUpdate),
+					resource.TestCheckResourceAttr(resourceName, "igmp.0.version", fmt.Sprint(*multicastUpdate.Igmp.Version)),
+					resource.TestCheckResourceAttr(resourceName, "igmp.0.query_interval", fmt.Sprint(*multicastUpdate.Igmp.QueryInterval)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAcMulticastConfig_Complete(multicast *models.MulticastAttributes) string {
+	return fmt.Sprintf(`
+	resource "agile_multicast" "this" {
+	  logic_network_id = "%s"
+	  logic_router_id  = "%s"
+      pim {
+		mode                = "%s"
+		hello_interval      = %d
+		join_prune_interval = %d
+      }
+      igmp {
+		version        = %d
+		query_interval = %d
+		robust_count   = %d
+      }
+      rendezvous_point {
+		address     = "%s"
+		group_range = "%s"
+      }
+      group_ranges = ["%s"]
+	}
+	`, *multicast.LogicNetworkId, *multicast.LogicRouterId,
+		*multicast.Pim.Mode, *multicast.Pim.HelloInterval, *multicast.Pim.JoinPruneInterval,
+		*multicast.Igmp.Version, *multicast.Igmp.QueryInterval, *multicast.Igmp.RobustCount,
+		*multicast.RendezvousPoints[0].Address, *multicast.RendezvousPoints[0].GroupRange,
+		*multicast.GroupRanges[0])
+}
+
+func testAccCheckAgileMulticastExists(name string, multicast *models.Multicast) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("multicast %s not found", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no multicast id was set")
+		}
+
+		agileClient := testAccProvider.Meta().(*providerMeta).client
+
+		multicastFound, err := agileClient.GetMulticast(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if *multicastFound.Id != rs.Primary.ID {
+			return fmt.Errorf("multicast %s not found", rs.Primary.ID)
+		}
+
+		*multicast = *multicastFound
+		return nil
+	}
+}
+
+func testAccCheckAgileMulticastAttributes(multicast *models.Multicast, attributes *models.MulticastAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if *multicast.LogicRouterId != *attributes.LogicRouterId {
+			return fmt.Errorf("bad multicast logical router id %s", *multicast.LogicRouterId)
+		}
+
+		if attributes.Pim != nil && *multicast.Pim.Mode != *attributes.Pim.Mode {
+			return fmt.Errorf("bad multicast pim mode %s", *multicast.Pim.Mode)
+		}
+
+		if attributes.Igmp != nil && *multicast.Igmp.Version != *attributes.Igmp.Version {
+			return fmt.Errorf("bad multicast igmp version %d", *multicast.Igmp.Version)
+		}
+
+		if len(multicast.RendezvousPoints) != len(attributes.RendezvousPoints) {
+			return fmt.Errorf("bad multicast rendezvous points size %d", len(multicast.RendezvousPoints))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgileMulticastDestroy(s *terraform.State) error {
+	agileClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+
+		if rs.Type == "agile_multicast" {
+			multicast, err := agileClient.GetMulticast(rs.Primary.ID)
+
+			if multicast != nil {
+				return fmt.Errorf("multicast %s still exists", rs.Primary.ID)
+			}
+
+			if err == nil {
+				return fmt.Errorf("multicast %s still exists", rs.Primary.ID)
+			}
+
+		} else {
+			continue
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+func resourceAgileQosPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages QoS Policies. A QoS policy can be applied to logical switches and logical ports through their `qos_policy_id` argument.",
+		CreateContext: resourceAgileQosPolicyCreate,
+		ReadContext:   resourceAgileQosPolicyRead,
+		UpdateContext: resourceAgileQosPolicyUpdate,
+		DeleteContext: resourceAgileQosPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgileQosPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "QoS policy ID.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "QoS policy name.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringDoesNotContainAny(" "),
+					),
+				),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "QoS policy description.",
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Description:  "Tenant to which the QoS policy belongs.",
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"car": {
+				Type:        schema.TypeList,
+				Description: "Committed Access Rate (CAR) Settings used to rate limit the traffic.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cir": {
+							Type:         schema.TypeInt,
+							Description:  "Committed information rate. The value range is from 0 to 4294967295 in kbit/s, 0 to 4294967 in Mbit/s, or 0 to 4294 in Gbit/s.",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 4294967295),
+						},
+						"cir_unit": {
+							Type:         schema.TypeString,
+							Description:  "CIR unit. The value can be kbps, mbps or gbps.",
+							Optional:     true,
+							Default:      "kbps",
+							ValidateFunc: validation.StringInSlice([]string{"kbps", "mbps", "gbps"}, false),
+						},
+						"pir": {
+							Type:         schema.TypeInt,
+							Description:  "Peak information rate. It must be greater than or equal to the CIR and uses the same unit.",
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 4294967295),
+						},
+						"cbs": {
+							Type:         schema.TypeInt,
+							Description:  "Committed burst size. The value range is from 10000 to 4294967295 in bytes, 9 to 4194303 in Kbytes, or 1 to 4095 in Mbytes.",
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 4294967295),
+						},
+						"pbs": {
+							Type:         schema.TypeInt,
+							Description:  "Peak burst size. It uses the same unit as the CBS.",
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 4294967295),
+						},
+						"cbs_unit": {
+							Type:         schema.TypeString,
+							Description:  "CBS and PBS unit. The value can be byte, kbytes or mbytes.",
+							Optional:     true,
+							Default:      "byte",
+							ValidateFunc: validation.StringInSlice([]string{"byte", "kbytes", "mbytes"}, false),
+						},
+					},
+				},
+			},
+			"dscp_remark": {
+				Type:         schema.TypeInt,
+				Description:  "DSCP value used to re-mark the traffic matching the policy. The value is an integer in the range from 0 to 63.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 63),
+			},
+			"priority_map": {
+				Type:        schema.TypeSet,
+				Description: "Mapping of DSCP values to 802.1p priorities.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dscp": {
+							Type:         schema.TypeInt,
+							Description:  "DSCP value. The value is an integer in the range from 0 to 63.",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 63),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Description:  "802.1p priority. The value is an integer in the range from 0 to 7.",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 7),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// qosPolicyCbsLimits holds the minimum and maximum CBS for each CBS unit.
+var qosPolicyCbsLimits = map[string][2]int{
+	"byte":   {10000, 4294967295},
+	"kbytes": {9, 4194303},
+	"mbytes": {1, 4095},
+}
+
+func resourceAgileQosPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] QoS Policy: Beginning Creation")
+
+	agileClient := meta.(*providerMeta).client
+
+	id, _ := uuid.NewV4()
+
+	name := d.Get("name").(string)
+
+	qosPolicy, err := NewQosPolicyAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if err := agileClient.CreateQosPolicy(agile.String(id.String()), agile.String(name), qosPolicy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceAgileQosPolicyRead(ctx, d, meta)
+}
+
+func resourceAgileQosPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	qosPolicy, err := getQosPolicy(agileMeta, id)
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	if _, err := setQosPolicyAttributes(qosPolicy, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+func resourceAgileQosPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: QoS Policy: Beginning Update", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	name := d.Get("name").(string)
+
+	qosPolicyAttr, err := NewQosPolicyAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := agileClient.UpdateQosPolicy(agile.String(d.Id()), agile.String(name), qosPolicyAttr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAgileQosPolicyRead(ctx, d, meta)
+}
+
+func resourceAgileQosPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	if err := agileClient.DeleteQosPolicy(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+
+	d.SetId("")
+
+	return diag.FromErr(nil)
+}
+
+func resourceAgileQosPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	qosPolicy, err := getQosPolicy(agileMeta, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schemaFilled, err := setQosPolicyAttributes(qosPolicy, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+
+	return []*schema.ResourceData{schemaFilled}, nil
+}
+
+func NewQosPolicyAttributes(d *schema.ResourceData) (*models.QosPolicyAttributes, diag.Diagnostics) {
+	qosPolicyAttr := models.QosPolicyAttributes{}
+
+	if _, ok := d.GetOk("description"); ok {
+		qosPolicyAttr.Description = agile.String(d.Get("description").(string))
+	}
+
+	if _, ok := d.GetOk("tenant_id"); ok {
+		qosPolicyAttr.TenantId = agile.String(d.Get("tenant_id").(string))
+	}
+
+	if val, ok := d.GetOk("car"); ok {
+		car := val.([]interface{})[0].(map[string]interface{})
+		qosPolicyAttr.Car = &models.QosPolicyCar{
+			Cir:     agile.Int64(int64(car["cir"].(int))),
+			CirUnit: agile.String(car["cir_unit"].(string)),
+			CbsUnit: agile.String(car["cbs_unit"].(string)),
+		}
+
+		if car["pir"].(int) != 0 {
+			if car["pir"].(int) < car["cir"].(int) {
+				return nil, diag.Errorf("pir must be greater than or equal to cir.")
+			}
+			qosPolicyAttr.Car.Pir = agile.Int64(int64(car["pir"].(int)))
+		}
+
+		if car["cbs"].(int) != 0 {
+			limits := qosPolicyCbsLimits[car["cbs_unit"].(string)]
+			if car["cbs"].(int) < limits[0] || car["cbs"].(int) > limits[1] {
+				return nil, diag.Errorf("cbs must be between %d and %d %s.", limits[0], limits[1], car["cbs_unit"].(string))
+			}
+			qosPolicyAttr.Car.Cbs = agile.Int64(int64(car["cbs"].(int)))
+		}
+
+		if car["pbs"].(int) != 0 {
+			if car["pbs"].(int) < car["cbs"].(int) {
+				return nil, diag.Errorf("pbs must be greater than or equal to cbs.")
+			}
+			qosPolicyAttr.Car.Pbs = agile.Int64(int64(car["pbs"].(int)))
+		}
+	}
+
+	if val, ok := d.GetOkExists("dscp_remark"); ok {
+		qosPolicyAttr.DscpRemark = agile.Int32(int32(val.(int)))
+	}
+
+	if val, ok := d.GetOk("priority_map"); ok {
+		qosPolicyAttr.PriorityMap = make([]*models.QosPolicyPriorityMap, 0)
+		for _, item := range val.(*schema.Set).List() {
+			priorityMap := item.(map[string]interface{})
+			qosPolicyAttr.PriorityMap = append(qosPolicyAttr.PriorityMap, &models.QosPolicyPriorityMap{
+				Dscp:     agile.Int32(int32(priorityMap["dscp"].(int))),
+				Priority: agile.Int32(int32(priorityMap["priority"].(int))),
+			})
+		}
+	}
+
+	return &qosPolicyAttr, nil
+}
+
+func setQosPolicyAttributes(qosPolicy *models.QosPolicy, d *schema.ResourceData) (*schema.ResourceData, error) {
+	if err := d.Set("name", qosPolicy.Name); err != nil {
+		return nil, err
+	}
+	if err := d.Set("description", qosPolicy.Description); err != nil {
+		return nil, err
+	}
+	if err := d.Set("tenant_id", qosPolicy.TenantId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("dscp_remark", qosPolicy.DscpRemark); err != nil {
+		return nil, err
+	}
+
+	if qosPolicy.Car == nil {
+		if err := d.Set("car", []interface{}{}); err != nil {
+			return nil, err
+		}
+	} else {
+		err := d.Set("car", []interface{}{
+			map[string]interface{}{
+				"cir":      qosPolicy.Car.Cir,
+				"cir_unit": qosPolicy.Car.CirUnit,
+				"pir":      qosPolicy.Car.Pir,
+				"cbs":      qosPolicy.Car.Cbs,
+				"pbs":      qosPolicy.Car.Pbs,
+				"cbs_unit": qosPolicy.Car.CbsUnit,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var priorityMaps []interface{}
+	for _, priorityMap := range qosPolicy.PriorityMap {
+		priorityMaps = append(priorityMaps, map[string]interface{}{
+			"dscp":     priorityMap.Dscp,
+			"priority": priorityMap.Priority,
+		})
+	}
+	if err := d.Set("priority_map", priorityMaps); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
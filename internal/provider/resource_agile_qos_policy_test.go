@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"fmt"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccAgileQosPolicy_Complete(t *testing.T) {
+	name := "tf_acc_tests_qosPolicy"
+
+	qosPolicyAttr := models.QosPolicyAttributes{
+		Description: agile.String("QoS Policy created via Terraform Tests"),
+		TenantId:    agile.String("cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"),
+		Car: &models.QosPolicyCar{
+			Cir:     agile.Int64(10000),
+			CirUnit: agile.String("kbps"),
+			Pir:     agile.Int64(20000),
+			Cbs:     agile.Int64(10000),
+			Pbs:     agile.Int64(20000),
+			CbsUnit: agile.String("byte"),
+		},
+		DscpRemark: agile.Int32(46),
+		PriorityMap: []*models.QosPolicyPriorityMap{
+			{
+				Dscp:     agile.Int32(46),
+				Priority: agile.Int32(5),
+			},
+		},
+	}
+
+	resourceName := "agile_qos_policy.this"
+	var qosPolicy models.QosPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileQosPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcQosPolicyConfig_Complete(name, &qosPolicyAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileQosPolicyExists(resourceName, &qosPolicy),
+					testAccCheckAgileQosPolicyAttributes(name, &qosPolicy, &qosPolicyAttr),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", *qosPolicyAttr.Description),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", *qosPolicyAttr.TenantId),
+					resource.TestCheckResourceAttr(resourceName, "dscp_remark", fmt.Sprint(*qosPolicyAttr.DscpRemark)),
+					resource.TestCheckResourceAttr(resourceName, "car.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "car.0.cir", fmt.Sprint(*qosPolicyAttr.Car.Cir)),
+					resource.TestCheckResourceAttr(resourceName, "car.0.cir_unit", *qosPolicyAttr.Car.CirUnit),
+					resource.TestCheckResourceAttr(resourceName, "car.0.pir", fmt.Sprint(*qosPolicyAttr.Car.Pir)),
+					resource.TestCheckResourceAttr(resourceName, "car.0.cbs", fmt.Sprint(*qosPolicyAttr.Car.Cbs)),
+					resource.TestCheckResourceAttr(resourceName, "car.0.pbs", fmt.Sprint(*qosPolicyAttr.Car.Pbs)),
+					resource.TestCheckResourceAttr(resourceName, "car.0.cbs_unit", *qosPolicyAttr.Car.CbsUnit),
+					resource.TestCheckResourceAttr(resourceName, "priority_map.#", fmt.Sprint(len(qosPolicyAttr.PriorityMap))),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+		},
+	})
+}
+
+func TestAccAgileQosPolicy_Update(t *testing.T) {
+	name := "tf_acc_tests_qosPolicy"
+
+	qosPolicyAttr := models.QosPolicyAttributes{
+		Description: agile.String("QoS Policy created via Terraform Tests"),
+		TenantId:    agile.String("cd27d9cf-9be0-4852-a560-2d6e05fd3c1e"),
+		Car: &models.QosPolicyCar{
+			Cir:     agile.Int64(10000),
+			CirUnit: agile.String("kbps"),
+			Pir:     agile.Int64(20000),
+			Cbs:     agile.Int64(10000),
+			Pbs:     agile.Int64(20000),
+			CbsUnit: agile.String("byte"),
+		},
+		DscpRemark: agile.Int32(46),
+		PriorityMap: []*models.QosPolicyPriorityMap{
+			{
+				Dscp:     agile.Int32(46),
+				Priority: agile.Int32(5),
+			},
+		},
+	}
+
+	qosPolicyUpdate := qosPolicyAttr
+	qosPolicyUpdate.Description = agile.String("QoS Policy Updated via Terraform Agile Provider Acceptance tests")
+	qosPolicyUpdate.Car = &models.QosPolicyCar{
+		Cir:     agile.Int64(50),
+		CirUnit: agile.String("mbps"),
+		Pir:     agile.Int64(100),
+		Cbs:     agile.Int64(10000),
+		Pbs:     agile.Int64(20000),
+		CbsUnit: agile.String("byte"),
+	}
+
+	resourceName := "agile_qos_policy.this"
+	var qosPolicy models.QosPolicy
+	var qosPolicyUpdated models.QosPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileQosPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcQosPolicyConfig_Complete(name, &qosPolicyAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileQosPolicyExists(resourceName, &qosPolicy),
+					testAccCheckAgileQosPolicyAttributes(name, &qosPolicy, &qosPolicyAttr),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			{
+				Config: testAccCheckAcQosPolicyConfig_Complete(name, &qosPolicyUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileQosPolicyExists(resourceName, &qosPolicyUpdated),
+					testAccCheckAgileQosPolicyAttributes(name, &qosPolicyUpdated, &qosPolicyUpdate),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", *qosPolicyUpdate.Description),
+					resource.TestCheckResourceAttr(resourceName, "car.0.cir", fmt.Sprint(*qosPolicyUpdate.Car.Cir)),
+					resource.TestCheckResourceAttr(resourceName, "car.0.cir_unit", *qosPolicyUpdate.Car.CirUnit),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgileQosPolicy_CbsBelowUnitMinimum(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileQosPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAcQosPolicyConfig_CbsBelowUnitMinimum,
+				ExpectError: regexp.MustCompile("cbs must be between 10000 and 4294967295 byte"),
+			},
+		},
+	})
+}
+
+const testAccCheckAcQosPolicyConfig_CbsBelowUnitMinimum = `
+resource "agile_qos_policy" "this" {
+	name = "tf_acc_tests_qos_policy"
+	car {
+		cir = 10000
+		cbs = 100
+	}
+}
+`
+
+func testAccCheckAcQosPolicyConfig_Complete(name string, qosPolicy *models.QosPolicyAttributes) string {
+	return fmt.Sprintf(`
+	resource "agile_qos_policy" "this" {
+	  name        = "%s"
+      description = "%s"
+	  tenant_id   = "%s"
+      dscp_remark = %d
+      car {
+		cir      = %d
+		cir_unit = "%s"
+		pir      = %d
+		cbs      = %d
+		pbs      = %d
+		cbs_unit = "%s"
+      }
+      priority_map {
+		dscp     = %d
+		priority = %d
+      }
+	}
+	`, name, *qosPolicy.Description, *qosPolicy.TenantId, *qosPolicy.DscpRemark,
+		*qosPolicy.Car.Cir, *qosPolicy.Car.CirUnit, *qosPolicy.Car.Pir, *qosPolicy.Car.Cbs, *qosPolicy.Car.Pbs, *qosPolicy.Car.CbsUnit,
+		*qosPolicy.PriorityMap[0].Dscp, *qosPolicy.PriorityMap[0].Priority)
+}
+
+func testAccCheckAgileQosPolicyExists(name string, qosPolicy *models.QosPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("qos policy %s not found", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no qos policy id was set")
+		}
+
+		agileClient := testAccProvider.Meta().(*providerMeta).client
+
+		qosPolicyFound, err := agileClient.GetQosPolicy(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if *qosPolicyFound.Id != rs.Primary.ID {
+			return fmt.Errorf("qos policy %s not found", rs.Primary.ID)
+		}
+
+		*qosPolicy = *qosPolicyFound
+		return nil
+	}
+}
+
+func testAccCheckAgileQosPolicyAttributes(name string, qosPolicy *models.QosPolicy, attributes *models.QosPolicyAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if name != *qosPolicy.Name {
+			return fmt.Errorf("bad qos policy Name %s", *qosPolicy.Name)
+		}
+
+		if attributes.Description != nil && *qosPolicy.Description != *attributes.Description {
+			return fmt.Errorf("bad qos policy description %s", *qosPolicy.Description)
+		}
+
+		if attributes.TenantId != nil && *qosPolicy.TenantId != *attributes.TenantId {
+			return fmt.Errorf("bad qos policy tenant id %s", *qosPolicy.TenantId)
+		}
+
+		if attributes.DscpRemark != nil && *qosPolicy.DscpRemark != *attributes.DscpRemark {
+			return fmt.Errorf("bad qos policy dscp remark %d", *qosPolicy.DscpRemark)
+		}
+
+		if attributes.Car != nil {
+			if *qosPolicy.Car.Cir != *attributes.Car.Cir {
+				return fmt.Errorf("bad qos policy car cir %d", *qosPolicy.Car.Cir)
+			}
+
+			if *qosPolicy.Car.CirUnit != *attributes.Car.CirUnit {
+				return fmt.Errorf("bad qos policy car cir unit %s", *qosPolicy.Car.CirUnit)
+			}
+		}
+
+		if len(qosPolicy.PriorityMap) != len(attributes.PriorityMap) {
+			return fmt.Errorf("bad qos policy priority map size %d", len(qosPolicy.PriorityMap))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgileQosPolicyDestroy(s *terraform.State) error {
+	agileClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+
+		if rs.Type == "agile_qos_policy" {
+			qosPolicy, err := agileClient.GetQosPolicy(rs.Primary.ID)
+
+			if qosPolicy != nil {
+				return fmt.Errorf("qos policy %s still exists", *qosPolicy.Name)
+			}
+
+			if err == nil {
+				return fmt.Errorf("qos policy %s still exists", rs.Primary.ID)
+			}
+
+		} else {
+			continue
+		}
+	}
+
+	return nil
+}
@@ -205,7 +205,7 @@ func resourceAgileTenant() *schema.Resource {
 
 func resourceAgileTenantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] Tenant: Beginning Creation")
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	id, _ := uuid.NewV4()
 
@@ -232,10 +232,10 @@ func resourceAgileTenantCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceAgileTenantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
-	tenant, err := agileClient.GetTenant(id)
+	tenant, err := getTenant(agileMeta, id)
 	if err != nil {
 		d.SetId("")
 		return nil
@@ -250,7 +250,7 @@ func resourceAgileTenantRead(ctx context.Context, d *schema.ResourceData, meta i
 
 func resourceAgileTenantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Tenant: Beginning Update", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	name := d.Get("name").(string)
 
@@ -271,7 +271,7 @@ func resourceAgileTenantUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceAgileTenantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileClient := meta.(*providerMeta).client
 
 	err := agileClient.DeleteTenant(d.Id())
 
@@ -288,11 +288,11 @@ func resourceAgileTenantDelete(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceAgileTenantImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
-	agileClient := meta.(*agile.Client)
+	agileMeta := meta.(*providerMeta)
 
 	id := d.Id()
 
-	tenant, err := agileClient.GetTenant(id)
+	tenant, err := getTenant(agileMeta, id)
 
 	if err != nil {
 		return nil, err
@@ -329,7 +329,7 @@ func testAccCheckAcTenantConfig_WithoutMulticast(name string, tenant *models.Ten
 }
 
 func GetFabrics() []*models.Fabric {
-	agileClient := testAccProvider.Meta().(*agile.Client)
+	agileClient := testAccProvider.Meta().(*providerMeta).client
 	fabrics, err := agileClient.ListFabrics(nil)
 	if err != nil {
 		panic("error query Fabrics")
@@ -339,7 +339,7 @@ func GetFabrics() []*models.Fabric {
 }
 
 func GetExternalGateways() []*models.ExternalGateway {
-	agileClient := testAccProvider.Meta().(*agile.Client)
+	agileClient := testAccProvider.Meta().(*providerMeta).client
 	externalGateways, err := agileClient.ListExternalGateways(nil)
 	if err != nil {
 		panic("error query External Gateways")
@@ -359,7 +359,7 @@ func testAccCheckAgileTenantExists(name string, tenant *models.Tenant) resource.
 			return fmt.Errorf("no tenant id was set")
 		}
 
-		agileClient := testAccProvider.Meta().(*agile.Client)
+		agileClient := testAccProvider.Meta().(*providerMeta).client
 
 		tenantFound, err := agileClient.GetTenant(rs.Primary.ID)
 		if err != nil {
@@ -433,7 +433,7 @@ func testAccCheckAgileTenantAttributes(name string, tenant *models.Tenant, attri
 }
 
 func testAccCheckAgileTenantDestroy(s *terraform.State) error {
-	agileClient := testAccProvider.Meta().(*agile.Client)
+	agileClient := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	uuid "github.com/nu7hatch/gouuid"
+	"terraform-provider-agile/tools"
+)
+
+func resourceAgileVpcConnection() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages VPC Connections. A VPC connection peers two logical networks through a Transit or Connect logical router.",
+		CreateContext: resourceAgileVpcConnectionCreate,
+		ReadContext:   resourceAgileVpcConnectionRead,
+		UpdateContext: resourceAgileVpcConnectionUpdate,
+		DeleteContext: resourceAgileVpcConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgileVpcConnectionImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "VPC connection ID.",
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "VPC connection name.",
+				Required:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringDoesNotContainAny(" "),
+					),
+				),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "VPC connection description.",
+				Optional:    true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"transit_router_id": {
+				Type:         schema.TypeString,
+				Description:  "Logical router interconnecting the VPCs. Its type must be Transit or Connect.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"peer": {
+				Type:        schema.TypeList,
+				Description: "VPCs interconnected by the connection.",
+				Required:    true,
+				MinItems:    2,
+				MaxItems:    2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logic_network_id": {
+							Type:         schema.TypeString,
+							Description:  "Logical network (VPC) to interconnect.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"logic_router_id": {
+							Type:         schema.TypeString,
+							Description:  "Logical router of the VPC attached to the transit router.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"export_cidrs": {
+							Type:        schema.TypeSet,
+							Description: "Routes of the VPC leaked to the other peer, in CIDR notation. If this parameter is left empty, no route is leaked.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+						"import_cidrs": {
+							Type:        schema.TypeSet,
+							Description: "Routes of the other peer accepted by the VPC, in CIDR notation. If this parameter is left empty, every leaked route is accepted.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "VPC connection status.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAgileVpcConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] VPC Connection: Beginning Creation")
+
+	agileMeta := meta.(*providerMeta)
+	agileClient := agileMeta.client
+
+	id, _ := uuid.NewV4()
+
+	name := d.Get("name").(string)
+
+	vpcConnection, err := NewVpcConnectionAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	transitRouter, errRouter := getLogicalRouter(agileMeta, *vpcConnection.TransitRouterId)
+
+	if errRouter != nil {
+		return diag.FromErr(errRouter)
+	}
+
+	if transitRouter.Type == nil || (*transitRouter.Type != "Transit" && *transitRouter.Type != "Connect") {
+		return diag.Errorf("Logical router %s must be of type Transit or Connect to interconnect VPCs.", *vpcConnection.TransitRouterId)
+	}
+
+	if err := agileClient.CreateVpcConnection(agile.String(id.String()), agile.String(name), vpcConnection); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceAgileVpcConnectionRead(ctx, d, meta)
+}
+
+func resourceAgileVpcConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	vpcConnection, err := getVpcConnection(agileMeta, id)
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	if _, err := setVpcConnectionAttributes(vpcConnection, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+
+	return nil
+}
+
+func resourceAgileVpcConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: VPC Connection: Beginning Update", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	name := d.Get("name").(string)
+
+	vpcConnectionAttr, err := NewVpcConnectionAttributes(d)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := agileClient.UpdateVpcConnection(agile.String(d.Id()), agile.String(name), vpcConnectionAttr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAgileVpcConnectionRead(ctx, d, meta)
+}
+
+func resourceAgileVpcConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	agileClient := meta.(*providerMeta).client
+
+	if err := agileClient.DeleteVpcConnection(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+
+	d.SetId("")
+
+	return diag.FromErr(nil)
+}
+
+func resourceAgileVpcConnectionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	agileMeta := meta.(*providerMeta)
+
+	id := d.Id()
+	vpcConnection, err := getVpcConnection(agileMeta, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schemaFilled, err := setVpcConnectionAttributes(vpcConnection, d)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+
+	return []*schema.ResourceData{schemaFilled}, nil
+}
+
+func NewVpcConnectionAttributes(d *schema.ResourceData) (*models.VpcConnectionAttributes, diag.Diagnostics) {
+	vpcConnectionAttr := models.VpcConnectionAttributes{
+		TransitRouterId: agile.String(d.Get("transit_router_id").(string)),
+	}
+
+	if _, ok := d.GetOk("description"); ok {
+		vpcConnectionAttr.Description = agile.String(d.Get("description").(string))
+	}
+
+	vpcConnectionAttr.Peers = make([]*models.VpcConnectionPeer, 0)
+	for _, item := range d.Get("peer").([]interface{}) {
+		peer := item.(map[string]interface{})
+		vpcConnectionAttr.Peers = append(vpcConnectionAttr.Peers, &models.VpcConnectionPeer{
+			LogicNetworkId: agile.String(peer["logic_network_id"].(string)),
+			LogicRouterId:  agile.String(peer["logic_router_id"].(string)),
+			ExportCidrs:    tools.ExtractSliceOfStrings(peer["export_cidrs"].(*schema.Set).List()),
+			ImportCidrs:    tools.ExtractSliceOfStrings(peer["import_cidrs"].(*schema.Set).List()),
+		})
+	}
+
+	if *vpcConnectionAttr.Peers[0].LogicNetworkId == *vpcConnectionAttr.Peers[1].LogicNetworkId {
+		return nil, diag.Errorf("A VPC connection must interconnect two different logical networks.")
+	}
+
+	return &vpcConnectionAttr, nil
+}
+
+func setVpcConnectionAttributes(vpcConnection *models.VpcConnection, d *schema.ResourceData) (*schema.ResourceData, error) {
+	if err := d.Set("name", vpcConnection.Name); err != nil {
+		return nil, err
+	}
+	if err := d.Set("description", vpcConnection.Description); err != nil {
+		return nil, err
+	}
+	if err := d.Set("transit_router_id", vpcConnection.TransitRouterId); err != nil {
+		return nil, err
+	}
+	if err := d.Set("status", vpcConnection.Status); err != nil {
+		return nil, err
+	}
+
+	var peers []interface{}
+	for _, peer := range vpcConnection.Peers {
+		peers = append(peers, map[string]interface{}{
+			"logic_network_id": peer.LogicNetworkId,
+			"logic_router_id":  peer.LogicRouterId,
+			"export_cidrs":     tools.CreateSliceOfStrings(peer.ExportCidrs),
+			"import_cidrs":     tools.CreateSliceOfStrings(peer.ImportCidrs),
+		})
+	}
+	if err := d.Set("peer", peers); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
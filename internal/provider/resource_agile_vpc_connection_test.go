@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"fmt"
+	agile "github.com/claranet/agilec-go-client/client"
+	"github.com/claranet/agilec-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccAgileVpcConnection_Complete(t *testing.T) {
+	name := "tf_acc_tests_vpcConnection"
+
+	vpcConnectionAttr := models.VpcConnectionAttributes{
+		Description:     agile.String("VPC Connection created via Terraform Tests"),
+		TransitRouterId: agile.String("2f5f6d7e-65b2-4a77-9b0e-3e7b8f1b9c01"),
+		Peers: []*models.VpcConnectionPeer{
+			{
+				LogicNetworkId: agile.String("5308df55-1709-404f-b4f8-4d8947d8f0c4"),
+				LogicRouterId:  agile.String("78750ef6-d054-4181-9143-4640dff220e1"),
+				ExportCidrs:    []*string{agile.String("10.0.0.0/16")},
+			},
+			{
+				LogicNetworkId: agile.String("acfd8aaf-c6dc-499d-8020-bebd85b1f0e6"),
+				LogicRouterId:  agile.String("9d3b1c2e-4f5a-4b6c-8d7e-0f1a2b3c4d5e"),
+				ExportCidrs:    []*string{agile.String("10.1.0.0/16")},
+			},
+		},
+	}
+
+	vpcConnectionUpdate := vpcConnectionAttr
+	vpcConnectionUpdate.Description = agile.String("VPC Connection Updated via Terraform Agile Provider Acceptance tests")
+
+	resourceName := "agile_vpc_connection.this"
+	var vpcConnection models.VpcConnection
+	var vpcConnectionUpdated models.VpcConnection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAgileVpcConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAcVpcConnectionConfig_Complete(name, &vpcConnectionAttr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileVpcConnectionExists(resourceName, &vpcConnection),
+					testAccCheckAgileVpcConnectionAttributes(name, &vpcConnection, &vpcConnectionAttr),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", *vpcConnectionAttr.Description),
+					resource.TestCheckResourceAttr(resourceName, "transit_router_id", *vpcConnectionAttr.TransitRouterId),
+					resource.TestCheckResourceAttr(resourceName, "peer.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "peer.0.logic_network_id", *vpcConnectionAttr.Peers[0].LogicNetworkId),
+					resource.TestCheckResourceAttr(resourceName, "peer.1.logic_network_id", *vpcConnectionAttr.Peers[1].LogicNetworkId),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			{
+				Config: testAccCheckAcVpcConnectionConfig_Complete(name, &vpcConnectionUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgileVpcConnectionExists(resourceName, &vpcConnectionUpdated),
+					testAccCheckAgileVpcConnectionAttributes(name, &vpcConnectionUpdated, &vpcConnectionUpdate),
+					resource.TestCheckResourceAttr(resourceName, "description", *vpcConnectionUpdate.Description),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAcVpcConnectionConfig_Complete(name string, vpcConnection *models.VpcConnectionAttributes) string {
+	return fmt.Sprintf(`
+	resource "agile_vpc_connection" "this" {
+	  name              = "%s"
+      description       = "%s"
+	  transit_router_id = "%s"
+      peer {
+		logic_network_id = "%s"
+		logic_router_id  = "%s"
+		export_cidrs     = ["%s"]
+      }
+      peer {
+		logic_network_id = "%s"
+		logic_router_id  = "%s"
+		export_cidrs     = ["%s"]
+      }
+	}
+	`, name, *vpcConnection.Description, *vpcConnection.TransitRouterId,
+		*vpcConnection.Peers[0].LogicNetworkId, *vpcConnection.Peers[0].LogicRouterId, *vpcConnection.Peers[0].ExportCidrs[0],
+		*vpcConnection.Peers[1].LogicNetworkId, *vpcConnection.Peers[1].LogicRouterId, *vpcConnection.Peers[1].ExportCidrs[0])
+}
+
+func testAccCheckAgileVpcConnectionExists(name string, vpcConnection *models.VpcConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return fmt.Errorf("vpc connection %s not found", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no vpc connection id was set")
+		}
+
+		agileClient := testAccProvider.Meta().(*providerMeta).client
+
+		vpcConnectionFound, err := agileClient.GetVpcConnection(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if *vpcConnectionFound.Id != rs.Primary.ID {
+			return fmt.Errorf("vpc connection %s not found", rs.Primary.ID)
+		}
+
+		*vpcConnection = *vpcConnectionFound
+		return nil
+	}
+}
+
+func testAccCheckAgileVpcConnectionAttributes(name string, vpcConnection *models.VpcConnection, attributes *models.VpcConnectionAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if name != *vpcConnection.Name {
+			return fmt.Errorf("bad vpc connection Name %s", *vpcConnection.Name)
+		}
+
+		if attributes.Description != nil && *vpcConnection.Description != *attributes.Description {
+			return fmt.Errorf("bad vpc connection description %s", *vpcConnection.Description)
+		}
+
+		if *vpcConnection.TransitRouterId != *attributes.TransitRouterId {
+			return fmt.Errorf("bad vpc connection transit router id %s", *vpcConnection.TransitRouterId)
+		}
+
+		for i, peer := range attributes.Peers {
+			if *vpcConnection.Peers[i].LogicNetworkId != *peer.LogicNetworkId {
+				return fmt.Errorf("bad vpc connection peer logical network id %s", *vpcConnection.Peers[i].LogicNetworkId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgileVpcConnectionDestroy(s *terraform.State) error {
+	agileClient := testAccProvider.Meta().(*providerMeta).client
+
+	for _, rs := range s.RootModule().Resources {
+
+		if rs.Type == "agile_vpc_connection" {
+			vpcConnection, err := agileClient.GetVpcConnection(rs.Primary.ID)
+
+			if vpcConnection != nil {
+				return fmt.Errorf("vpc connection %s still exists", *vpcConnection.Name)
+			}
+
+			if err == nil {
+				return fmt.Errorf("vpc connection %s still exists", rs.Primary.ID)
+			}
+
+		} else {
+			continue
+		}
+	}
+
+	return nil
+}